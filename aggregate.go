@@ -0,0 +1,84 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Bucket is the number of rows with the value of the group_by column.
+type Bucket struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// Aggregate counts the rows per value of the column requested with the group_by param, searched and
+// filtered the same way as with FilterByQueryConfig, pagination and order are ignored. Only filterable
+// and sortable fields could be grouped by, the rest are ignored or rejected in the strict mode.
+// No buckets are returned if GroupBy isn't set in the config or there is nothing to group by.
+// Example:
+//
+//	// ?group_by=organization_id&filter=role:admin
+//	buckets, err := filter.Aggregate(c, db.Model(&User{}), filter.Config{Filter: true, GroupBy: true})
+func Aggregate(c *gin.Context, db *gorm.DB, config Config, opts ...Option) ([]Bucket, error) {
+	o := newOptions(opts).forRequest(c)
+	config = Config{Search: config.Search, Filter: config.Filter, GroupBy: config.GroupBy}
+
+	var (
+		column clause.Column
+		found  bool
+	)
+	query, err := parseQuery(requestValues(c.Request, o), config, o)
+	if err == nil {
+		column, found, err = groupByColumn(db, query.GroupBy, o)
+	}
+	if err == nil && found {
+		db, err = applyQuery(db, c, query, config, o, nil)
+	}
+	if err != nil {
+		_ = addError(db, c, o, err)
+		return nil, db.Error
+	}
+	if !found {
+		return nil, nil
+	}
+
+	var buckets []Bucket
+	err = db.Select("? AS value, COUNT(*) AS count", column).
+		Clauses(clause.GroupBy{Columns: []clause.Column{column}}).
+		Order(clause.OrderByColumn{Column: column}).
+		Scan(&buckets).Error
+	return buckets, err
+}
+
+// groupByColumn returns the column of the filterable or sortable field with the param, the expression of the expression
+// fields, not found if there is none. The fields which aren't columns or expressions are rejected.
+func groupByColumn(db *gorm.DB, param string, o *options) (clause.Column, bool, error) {
+	if param == "" {
+		return clause.Column{}, false, nil
+	}
+	if _, meta, ok := queryMeta(db, o); ok {
+		for _, field := range meta.fields {
+			if !(field.Filterable || field.Sortable) || field.param != param {
+				continue
+			}
+			if column, ok := field.column.(clause.Column); ok {
+				return column, true, nil
+			}
+			if field.Expr != "" && !field.Having {
+				return clause.Column{Name: field.Expr, Raw: true}, true, nil
+			}
+			// The relation counts and the aggregates are computed per row or per group, so they can't be grouped by.
+			return clause.Column{}, false, &Error{Param: "group_by", Value: param, Reason: "can't be grouped by"}
+		}
+	}
+	if o.strict {
+		return clause.Column{}, false, &Error{Param: "group_by", Value: param, Reason: "unknown field"}
+	}
+	return clause.Column{}, false, nil
+}
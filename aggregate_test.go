@@ -0,0 +1,97 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestAggregate is a test for the buckets grouped by the group_by column.
+func (s *TestSuite) TestAggregate() {
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?group_by=email&filter=login:sampleUser&page=2&order_by=id", nil)}
+
+	s.mock.ExpectQuery(`^SELECT "users"."email" AS value, COUNT\(\*\) AS count FROM "users" WHERE "users"."username" = \$1 ` +
+		`GROUP BY "users"."email" ORDER BY "users"."email"$`).
+		WithArgs("sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"value", "count"}).AddRow("a@example.com", 3).AddRow("b@example.com", 1))
+	buckets, err := Aggregate(&ctx, s.db.Model(&User{}), Config{Filter: true, Paginate: true, OrderBy: true, GroupBy: true})
+	s.Require().NoError(err)
+	s.Equal([]Bucket{{Value: "a@example.com", Count: 3}, {Value: "b@example.com", Count: 1}}, buckets)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+// TestAggregateFilterHeader is a test for the buckets filtered by the filter phrases of the header.
+func (s *TestSuite) TestAggregateFilterHeader() {
+	r := httptest.NewRequest(http.MethodGet, "/users?group_by=email", nil)
+	r.Header.Set("X-Filter", "login:sampleUser")
+	ctx := gin.Context{Request: r}
+
+	s.mock.ExpectQuery(`^SELECT "users"."email" AS value, COUNT\(\*\) AS count FROM "users" WHERE "users"."username" = \$1 ` +
+		`GROUP BY "users"."email" ORDER BY "users"."email"$`).
+		WithArgs("sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"value", "count"}).AddRow("a@example.com", 3))
+	buckets, err := Aggregate(&ctx, s.db.Model(&User{}), Config{Filter: true, GroupBy: true}, WithFilterHeader("X-Filter"))
+	s.Require().NoError(err)
+	s.Equal([]Bucket{{Value: "a@example.com", Count: 3}}, buckets)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+// TestAggregateIgnored is a test for the group_by param which isn't grouped by.
+func (s *TestSuite) TestAggregateIgnored() {
+	for _, test := range []struct {
+		query  string
+		config Config
+	}{
+		{"group_by=email", Config{Filter: true}},
+		{"group_by=password", Config{GroupBy: true}},
+		{"filter=login:sampleUser", Config{Filter: true, GroupBy: true}},
+	} {
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?"+test.query, nil)}
+		buckets, err := Aggregate(&ctx, s.db.Model(&User{}), test.config)
+		s.NoError(err, test.query)
+		s.Nil(buckets, test.query)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+// TestAggregateStrict is a test for rejecting the group_by field which can't be grouped by in the strict mode.
+func (s *TestSuite) TestAggregateStrict() {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/users?group_by=password", nil)
+	buckets, err := Aggregate(ctx, s.db.Model(&User{}), Config{GroupBy: true}, WithStrict())
+
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal("group_by", filterErr.Param)
+	s.Nil(buckets)
+	s.Equal(http.StatusBadRequest, ctx.Writer.Status())
+}
+
+// TestAggregateExpressions is a test for grouping by the expression fields and rejecting the relation counts.
+func (s *TestSuite) TestAggregateExpressions() {
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/people?group_by=display_name", nil)}
+	s.mock.ExpectQuery(`^SELECT COALESCE\(nickname, full_name\) AS value, COUNT\(\*\) AS count FROM "people" ` +
+		`GROUP BY COALESCE\(nickname, full_name\) ORDER BY COALESCE\(nickname, full_name\)$`).
+		WillReturnRows(sqlmock.NewRows([]string{"value", "count"}).AddRow("John", 2))
+	buckets, err := Aggregate(&ctx, s.db.Model(&Person{}), Config{GroupBy: true})
+	s.Require().NoError(err)
+	s.Equal([]Bucket{{Value: "John", Count: 2}}, buckets)
+
+	count, _ := gin.CreateTestContext(httptest.NewRecorder())
+	count.Request = httptest.NewRequest(http.MethodGet, "/organizations?group_by=users.count", nil)
+	buckets, err = Aggregate(count, s.db.Model(&Organization{}), Config{GroupBy: true})
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal(&Error{Param: "group_by", Value: "users.count", Reason: "can't be grouped by"}, filterErr)
+	s.Nil(buckets)
+	s.Equal(http.StatusBadRequest, count.Writer.Status())
+	s.NoError(s.mock.ExpectationsWereMet())
+}
@@ -0,0 +1,440 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ListRequest is the AIP-132 list request, e.g. generated by protoc.
+type ListRequest interface {
+	GetFilter() string
+	GetOrderBy() string
+	GetPageSize() int32
+	GetPageToken() string
+}
+
+// FromListRequest filters DB request with the AIP-160 filter, order_by, page_size and page_token
+// of the list request. The supported filter subset is comparisons (=, !=, <, <=, >, >=) of the filterable
+// fields, AND, OR, parentheses and quoted strings, other constructs are rejected with the *Error, and only
+// the sortable fields could be ordered by. The filter is applied along with the forced conditions, the field
+// policy and the audit hook of the options, with the nil gin context. Page tokens are made with PageToken.
+// Example:
+//
+//	scope, err := filter.FromListRequest(req, &User{})
+//	if err != nil {
+//		return nil, status.Error(codes.InvalidArgument, err.Error())
+//	}
+//	err = db.Model(&User{}).Scopes(scope).Find(&users).Error
+func FromListRequest(req ListRequest, model interface{}, opts ...Option) (func(db *gorm.DB) *gorm.DB, error) {
+	o := newOptions(opts)
+	var meta *modelMeta
+	if req.GetFilter() != "" || req.GetOrderBy() != "" {
+		var ok bool
+		if _, meta, ok = defaultQueryMeta(model, o); !ok {
+			return nil, fmt.Errorf("filter: the model %T can't be introspected", model)
+		}
+		if o.fieldPolicy != nil {
+			meta = o.forRequest(nil).policyMeta(meta)
+		}
+	}
+	var fields []fieldMeta
+	if meta != nil {
+		fields = meta.fields
+	}
+	root, err := parseAIPFilter(req.GetFilter(), fields)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := parseAIPOrderBy(req.GetOrderBy(), fields)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := parsePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	params := queryParams{PageSize: int(req.GetPageSize())}
+	normalizePagination(&params)
+	// The models could page beyond the default maximum.
+	pageSize := min(max(int(req.GetPageSize()), params.PageSize), pageSizeLimit(model, o))
+	var conditions []Condition
+	if root != nil {
+		conditions = root.appendConditions(nil)
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		query := Query{Filters: slices.Clone(conditions)}
+		if root != nil {
+			table, meta, ok := queryMeta(db, o)
+			if !ok {
+				_ = addError(db, nil, o, ErrNoModel)
+				return db
+			}
+			columns := make(map[string]string, len(meta.fields))
+			for _, field := range meta.fields {
+				columns[field.Name] = field.Column
+			}
+			query.where = root.expression(table, columns, o)
+		}
+		db, err := applyQuery(db, nil, query, Config{Filter: true}, o, nil)
+		if err != nil {
+			_ = addError(db, nil, o, err)
+			return db
+		}
+		return db.Order(clause.OrderBy{Columns: orders}).Offset(offset).Limit(pageSize)
+	}, nil
+}
+
+// PageToken returns the page token of the list request starting at the offset,
+// e.g. the next page token is PageToken(offset + pageSize) if the page is full.
+func PageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func parsePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		var offset int
+		if offset, err = strconv.Atoi(string(decoded)); err == nil && offset >= 0 {
+			return offset, nil
+		}
+	}
+	return 0, &Error{Param: "page_token", Value: token, Reason: "malformed page token"}
+}
+
+// parseAIPOrderBy parses the comma separated sortable fields with the optional desc suffix, e.g. "name, age desc".
+func parseAIPOrderBy(orderBy string, fields []fieldMeta) ([]clause.OrderByColumn, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return []clause.OrderByColumn{{Column: clause.Column{Name: "id"}, Desc: true}}, nil
+	}
+
+	var orders []clause.OrderByColumn
+	for _, item := range strings.Split(orderBy, ",") {
+		words := strings.Fields(item)
+		if len(words) == 0 || len(words) > 2 {
+			return nil, &Error{Param: "order_by", Value: orderBy, Reason: fmt.Sprintf("malformed item %q", strings.TrimSpace(item))}
+		}
+		index := slices.IndexFunc(fields, func(field fieldMeta) bool { return field.Sortable && field.param == words[0] })
+		if index < 0 {
+			return nil, &Error{Param: "order_by", Value: orderBy, Reason: fmt.Sprintf("field %q is not sortable", words[0])}
+		}
+		order := clause.OrderByColumn{Column: clause.Column{Name: fields[index].Column}}
+		if fields[index].Expr != "" {
+			order.Column = clause.Column{Name: fields[index].Expr, Raw: true}
+		}
+		if len(words) == 2 {
+			switch words[1] {
+			case "asc":
+			case "desc":
+				order.Desc = true
+			default:
+				return nil, &Error{Param: "order_by", Value: orderBy, Reason: fmt.Sprintf("unknown direction %q", words[1])}
+			}
+		}
+		orders = append(orders, order)
+	}
+	return orders, nil
+}
+
+type aipTokenKind int
+
+const (
+	aipEOF aipTokenKind = iota
+	aipText
+	aipString
+	aipComparator
+	aipLeftParen
+	aipRightParen
+)
+
+type aipToken struct {
+	kind aipTokenKind
+	text string
+	pos  int
+}
+
+// aipNode is the node of the parsed filter, built into the expression when the scope is applied.
+type aipNode interface {
+	expression(table string, columns map[string]string, o *options) clause.Expression
+	// appendConditions appends the conditions of the comparisons, e.g. for the audit hook.
+	appendConditions(conditions []Condition) []Condition
+}
+
+// aipGroup is the conjunction or the disjunction of the nodes.
+type aipGroup struct {
+	or    bool
+	nodes []aipNode
+}
+
+func (g aipGroup) expression(table string, columns map[string]string, o *options) clause.Expression {
+	expressions := make([]clause.Expression, 0, len(g.nodes))
+	for _, node := range g.nodes {
+		expressions = append(expressions, node.expression(table, columns, o))
+	}
+	if g.or {
+		return joinExpressions(expressions, clause.Or)
+	}
+	return joinExpressions(expressions, clause.And)
+}
+
+func (g aipGroup) appendConditions(conditions []Condition) []Condition {
+	for _, node := range g.nodes {
+		conditions = node.appendConditions(conditions)
+	}
+	return conditions
+}
+
+// aipRestriction is the comparison of the field with the value.
+type aipRestriction struct {
+	field    fieldMeta
+	operator string
+	value    string
+}
+
+func (r aipRestriction) expression(table string, columns map[string]string, o *options) clause.Expression {
+	operator := r.operator
+	if operator == "=" {
+		operator = ":"
+	}
+	return o.filterExpression(clause.Column{Table: table, Name: columns[r.field.Name]}, operator, r.value)
+}
+
+func (r aipRestriction) appendConditions(conditions []Condition) []Condition {
+	operator := r.operator
+	if operator == "=" {
+		operator = ":"
+	}
+	return append(conditions, Condition{
+		Field: r.field.Name, Param: r.field.param, Column: r.field.Column, Operator: operator, Value: r.value, PII: r.field.PII,
+	})
+}
+
+type aipParser struct {
+	filter string
+	tokens []aipToken
+	pos    int
+	fields []fieldMeta
+}
+
+// parseAIPFilter parses the AIP-160 filter against the filterable fields, the empty filter is nil.
+func parseAIPFilter(filter string, fields []fieldMeta) (aipNode, error) {
+	p := &aipParser{filter: filter, fields: fields}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+	if p.peek().kind == aipEOF {
+		return nil, nil
+	}
+	node, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if token := p.peek(); token.kind != aipEOF {
+		return nil, p.errorf(token, "unexpected %q", token.text)
+	}
+	return node, nil
+}
+
+func (p *aipParser) errorf(token aipToken, format string, args ...interface{}) error {
+	return &Error{Param: "filter", Value: p.filter, Reason: fmt.Sprintf("%s at %d", fmt.Sprintf(format, args...), token.pos)}
+}
+
+func (p *aipParser) tokenize() error {
+	for i := 0; i < len(p.filter); {
+		switch c := p.filter[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			p.tokens = append(p.tokens, aipToken{kind: aipLeftParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			p.tokens = append(p.tokens, aipToken{kind: aipRightParen, text: ")", pos: i})
+			i++
+		case c == '"' || c == '\'':
+			var value strings.Builder
+			start := i
+			for i++; i < len(p.filter) && p.filter[i] != c; i++ {
+				if p.filter[i] == '\\' && i+1 < len(p.filter) {
+					i++
+				}
+				value.WriteByte(p.filter[i])
+			}
+			if i == len(p.filter) {
+				return p.errorf(aipToken{pos: start}, "unterminated string")
+			}
+			p.tokens = append(p.tokens, aipToken{kind: aipString, text: value.String(), pos: start})
+			i++
+		case strings.IndexByte("=!<>:", c) >= 0:
+			start := i
+			i++
+			if i < len(p.filter) && p.filter[i] == '=' && c != '=' && c != ':' {
+				i++
+			}
+			if p.filter[start:i] == "!" {
+				return p.errorf(aipToken{pos: start}, "unexpected %q", "!")
+			}
+			p.tokens = append(p.tokens, aipToken{kind: aipComparator, text: p.filter[start:i], pos: start})
+		default:
+			start := i
+			for i < len(p.filter) && strings.IndexByte(" \t\n\r()\"'=!<>:", p.filter[i]) < 0 {
+				i++
+			}
+			p.tokens = append(p.tokens, aipToken{kind: aipText, text: p.filter[start:i], pos: start})
+		}
+	}
+	p.tokens = append(p.tokens, aipToken{kind: aipEOF, text: "end of filter", pos: len(p.filter)})
+	return nil
+}
+
+func (p *aipParser) peek() aipToken {
+	return p.tokens[p.pos]
+}
+
+func (p *aipParser) next() aipToken {
+	token := p.tokens[p.pos]
+	if token.kind != aipEOF {
+		p.pos++
+	}
+	return token
+}
+
+func (p *aipParser) keyword(keyword string) bool {
+	token := p.peek()
+	return token.kind == aipText && token.text == keyword
+}
+
+// parseExpression parses the sequences joined with AND.
+func (p *aipParser) parseExpression() (aipNode, error) {
+	group := aipGroup{}
+	for {
+		node, err := p.parseSequence()
+		if err != nil {
+			return nil, err
+		}
+		group.nodes = append(group.nodes, node)
+		if !p.keyword("AND") {
+			break
+		}
+		p.next()
+	}
+	return group.node(), nil
+}
+
+// parseSequence parses the factors separated with whitespace, which are implicitly joined with AND.
+func (p *aipParser) parseSequence() (aipNode, error) {
+	group := aipGroup{}
+	for {
+		node, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		group.nodes = append(group.nodes, node)
+		token := p.peek()
+		if token.kind == aipEOF || token.kind == aipRightParen || p.keyword("AND") {
+			break
+		}
+	}
+	return group.node(), nil
+}
+
+// parseFactor parses the terms joined with OR, which takes precedence over AND in AIP-160.
+func (p *aipParser) parseFactor() (aipNode, error) {
+	group := aipGroup{or: true}
+	for {
+		node, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		group.nodes = append(group.nodes, node)
+		if !p.keyword("OR") {
+			break
+		}
+		p.next()
+	}
+	return group.node(), nil
+}
+
+func (p *aipParser) parseTerm() (aipNode, error) {
+	token := p.next()
+	switch {
+	case token.kind == aipLeftParen:
+		node, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != aipRightParen {
+			return nil, p.errorf(closing, "expected %q, got %q", ")", closing.text)
+		}
+		return node, nil
+	case token.kind == aipText && (token.text == "NOT" || strings.HasPrefix(token.text, "-")):
+		return nil, p.errorf(token, "negation is not supported")
+	case token.kind == aipText && (token.text == "AND" || token.text == "OR"):
+		return nil, p.errorf(token, "unexpected %q", token.text)
+	case token.kind == aipText:
+		return p.parseRestriction(token)
+	default:
+		return nil, p.errorf(token, "expected field, got %q", token.text)
+	}
+}
+
+func (p *aipParser) parseRestriction(name aipToken) (aipNode, error) {
+	if p.peek().kind == aipLeftParen {
+		return nil, p.errorf(name, "functions are not supported")
+	}
+	if strings.Contains(name.text, ".") {
+		return nil, p.errorf(name, "field traversal %q is not supported", name.text)
+	}
+	field, ok := p.field(name.text)
+	if !ok {
+		return nil, p.errorf(name, "field %q is not filterable", name.text)
+	}
+
+	comparator := p.next()
+	switch {
+	case comparator.kind != aipComparator:
+		return nil, p.errorf(comparator, "expected comparator after %q, got %q", name.text, comparator.text)
+	case comparator.text == ":":
+		return nil, p.errorf(comparator, "has operator is not supported")
+	}
+
+	value := p.next()
+	switch {
+	case value.kind != aipText && value.kind != aipString:
+		return nil, p.errorf(value, "expected value after %q, got %q", comparator.text, value.text)
+	case value.kind == aipText && p.peek().kind == aipLeftParen:
+		return nil, p.errorf(value, "functions are not supported")
+	}
+	return aipRestriction{field: field, operator: comparator.text, value: value.text}, nil
+}
+
+// field looks up the filterable field by the query param name.
+func (p *aipParser) field(param string) (fieldMeta, bool) {
+	for _, field := range p.fields {
+		if field.Filterable && field.param == param {
+			return field, true
+		}
+	}
+	return fieldMeta{}, false
+}
+
+// node returns the single node of the group unwrapped.
+func (g aipGroup) node() aipNode {
+	if len(g.nodes) == 1 {
+		return g.nodes[0]
+	}
+	return g
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm/clause"
+)
+
+type listRequest struct {
+	Filter    string
+	OrderBy   string
+	PageSize  int32
+	PageToken string
+}
+
+func (r listRequest) GetFilter() string    { return r.Filter }
+func (r listRequest) GetOrderBy() string   { return r.OrderBy }
+func (r listRequest) GetPageSize() int32   { return r.PageSize }
+func (r listRequest) GetPageToken() string { return r.PageToken }
+
+// TestListRequestComparisons is a test for the AIP-160 comparison operators.
+func (s *TestSuite) TestListRequestComparisons() {
+	for _, tt := range []struct {
+		filter   string
+		operator string
+	}{
+		{`id = 5`, `=`},
+		{`id != 5`, `<>`},
+		{`id < 5`, `<`},
+		{`id <= 5`, `<=`},
+		{`id > 5`, `>`},
+		{`id >= 5`, `>=`},
+	} {
+		var users []User
+		scope, err := FromListRequest(listRequest{Filter: tt.filter}, &User{})
+		s.Require().NoError(err, tt.filter)
+
+		s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."id" `+tt.operator+` \$1 ORDER BY "id" DESC LIMIT \$2$`).
+			WithArgs("5", 10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+		err = s.db.Model(&User{}).Scopes(scope).Find(&users).Error
+		s.NoError(err, tt.filter)
+	}
+}
+
+// TestListRequestParentheses is a test for the parenthesized AIP-160 filter with ordering and paging.
+func (s *TestSuite) TestListRequestParentheses() {
+	var users []User
+	scope, err := FromListRequest(listRequest{
+		Filter:    `(login = "John Doe" OR email = 'john@example.com') AND id > 3`,
+		PageSize:  20,
+		PageToken: PageToken(40),
+	}, &User{})
+	s.Require().NoError(err)
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" = \$1 OR "users"."email" = \$2\) AND "users"."id" > \$3 ORDER BY "id" DESC LIMIT \$4 OFFSET \$5$`).
+		WithArgs("John Doe", "john@example.com", "3", 20, 40).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err = s.db.Model(&User{}).Scopes(scope).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestListRequestUnsupported is a test for rejecting the unsupported AIP-160 constructs.
+func (s *TestSuite) TestListRequestUnsupported() {
+	for filter, reason := range map[string]string{
+		`NOT id = 5`:           "negation is not supported at 0",
+		`-id = 5`:              "negation is not supported at 0",
+		`login:bob`:            "has operator is not supported at 5",
+		`name = "John"`:        `field "name" is not filterable at 0`,
+		`organization.id = 5`:  `field traversal "organization.id" is not supported at 0`,
+		`id = max(5)`:          "functions are not supported at 5",
+		`(id = 5`:              `expected ")", got "end of filter" at 7`,
+		`login = "bob`:         "unterminated string at 8",
+		`id = 5 AND`:           `expected field, got "end of filter" at 10`,
+		`id = 5 OR OR login=a`: `unexpected "OR" at 10`,
+	} {
+		_, err := FromListRequest(listRequest{Filter: filter}, &User{})
+		var filterErr *Error
+		s.Require().True(errors.As(err, &filterErr), filter)
+		s.Equal("filter", filterErr.Param)
+		s.Equal(reason, filterErr.Reason, filter)
+	}
+
+	_, err := FromListRequest(listRequest{OrderBy: "id up"}, &User{})
+	s.Error(err)
+	_, err = FromListRequest(listRequest{PageToken: "page-2"}, &User{})
+	s.Error(err)
+}
+
+type Novel struct {
+	Id        uint   `filter:"param:id;filterable;sortable"`
+	Title     string `filter:"param:name;filterable;sortable"`
+	Isbn      string `filter:"filterable"`
+	TenantId  uint
+	Published bool
+}
+
+// TestListRequestOrderBy is a test for ordering by the sortable fields only.
+func (s *TestSuite) TestListRequestOrderBy() {
+	var novels []Novel
+	scope, err := FromListRequest(listRequest{OrderBy: "name, id desc"}, &Novel{})
+	s.Require().NoError(err)
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "novels" ORDER BY "title","id" DESC LIMIT \$1$`).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title"}))
+	err = s.db.Model(&Novel{}).Scopes(scope).Find(&novels).Error
+	s.NoError(err)
+
+	for orderBy, reason := range map[string]string{
+		"isbn":      `field "isbn" is not sortable`,
+		"tenant_id": `field "tenant_id" is not sortable`,
+		"password":  `field "password" is not sortable`,
+	} {
+		_, err := FromListRequest(listRequest{OrderBy: orderBy}, &Novel{})
+		var filterErr *Error
+		s.Require().True(errors.As(err, &filterErr), orderBy)
+		s.Equal("order_by", filterErr.Param)
+		s.Equal(reason, filterErr.Reason, orderBy)
+	}
+}
+
+// TestListRequestOptions is a test for the forced conditions, the field policy and the audit hook of the list request.
+func (s *TestSuite) TestListRequestOptions() {
+	var (
+		novels  []Novel
+		audited []Query
+	)
+	opts := []Option{
+		WithForcedConditions(func(c *gin.Context) []clause.Expression {
+			return []clause.Expression{clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: "tenant_id"}, Value: 7}}
+		}),
+		WithAuditHook(func(c *gin.Context, query Query) { audited = append(audited, query) }),
+	}
+	scope, err := FromListRequest(listRequest{Filter: `name = "Dune" OR isbn = 42`}, &Novel{}, opts...)
+	s.Require().NoError(err)
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "novels" WHERE \("novels"."title" = \$1 OR "novels"."isbn" = \$2\) AND "novels"."tenant_id" = \$3 ORDER BY "id" DESC LIMIT \$4$`).
+		WithArgs("Dune", "42", 7, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title"}))
+	err = s.db.Model(&Novel{}).Scopes(scope).Find(&novels).Error
+	s.NoError(err)
+	s.Require().Len(audited, 1)
+	s.Equal([]Condition{
+		{Field: "Title", Param: "name", Column: "title", Operator: ":", Value: "Dune"},
+		{Field: "Isbn", Param: "isbn", Column: "isbn", Operator: ":", Value: "42"},
+	}, audited[0].Filters)
+
+	policy := WithFieldPolicy(func(c *gin.Context, field FieldMeta) Capability {
+		if field.Param == "isbn" || field.Param == "name" {
+			return CapabilityFilter
+		}
+		return CapabilityAll
+	})
+	_, err = FromListRequest(listRequest{Filter: `isbn = 42`}, &Novel{}, policy)
+	s.NoError(err)
+	_, err = FromListRequest(listRequest{OrderBy: "name"}, &Novel{}, policy)
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal(`field "name" is not sortable`, filterErr.Reason)
+
+	_, err = FromListRequest(listRequest{Filter: `id = 1`}, nil)
+	s.Error(err)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// TestAllowAll is a test for gating all=true with the callback.
+func (s *TestSuite) TestAllowAll() {
+	serviceOnly := WithAllowAll(func(c *gin.Context) bool {
+		return c.GetString("role") == "service"
+	})
+
+	var users []User
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/users?all=true", nil)
+	ctx.Set("role", "service")
+	s.mock.ExpectQuery(`^SELECT \* FROM "users"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(ctx, PAGINATE, serviceOnly)).Find(&users).Error
+	s.NoError(err)
+
+	ctx, _ = gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/users?all=true", nil)
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" LIMIT \$1$`).
+		WithArgs(defaultPageSize).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	scope, meta := ParseAndScope(ctx, PAGINATE, serviceOnly)
+	err = s.db.Model(&User{}).Scopes(scope).Find(&users).Error
+	s.NoError(err)
+	s.Equal(defaultPageSize, meta.PageSize)
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	ctx, _ = gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/users?all=true", nil)
+	err = s.db.Session(&gorm.Session{DryRun: true}).Model(&User{}).
+		Scopes(FilterByQuery(ctx, PAGINATE, serviceOnly, WithStrict())).Find(&users).Error
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal(&Error{Param: "all", Value: "true", Reason: "not allowed", Status: http.StatusForbidden}, filterErr)
+	s.Equal(http.StatusForbidden, ctx.Writer.Status())
+}
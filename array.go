@@ -0,0 +1,77 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm/clause"
+)
+
+// anyArray is the IN list bound as the single array parameter on postgres, `column = ANY($1)`,
+// so the long lists don't have a placeholder per element. It's the expanded IN for the other dialects.
+type anyArray clause.IN
+
+func (a anyArray) Build(builder clause.Builder) {
+	if !a.array(builder) {
+		clause.IN(a).Build(builder)
+		return
+	}
+	a.build(builder, " = ANY(")
+}
+
+func (a anyArray) NegationBuild(builder clause.Builder) {
+	if !a.array(builder) {
+		clause.IN(a).NegationBuild(builder)
+		return
+	}
+	a.build(builder, " <> ALL(")
+}
+
+// array reports whether the values are bound as the array for the dialect of the builder.
+func (a anyArray) array(builder clause.Builder) bool {
+	return len(a.Values) > 0 && dialect(builder) == "postgres"
+}
+
+func (a anyArray) build(builder clause.Builder, operator string) {
+	builder.WriteQuoted(a.Column)
+	builder.WriteString(operator)
+	builder.AddVar(builder, arrayValue(a.Values))
+	builder.WriteByte(')')
+}
+
+// arrayElementReplacer escapes the quoted elements of the array literals.
+var arrayElementReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// arrayValue is the postgres array literal of the values, e.g. {"a","b"}, cast to the column type by the server.
+type arrayValue []interface{}
+
+func (a arrayValue) Value() (driver.Value, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, value := range a {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if valuer, ok := value.(driver.Valuer); ok {
+			var err error
+			if value, err = valuer.Value(); err != nil {
+				return nil, err
+			}
+		}
+		if value == nil {
+			b.WriteString("NULL")
+			continue
+		}
+		b.WriteByte('"')
+		b.WriteString(arrayElementReplacer.Replace(fmt.Sprint(value)))
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// modelMeta is the filtering metadata of the model fields.
+type modelMeta struct {
+	modelType   reflect.Type // nil for the table fields
+	fields      []fieldMeta
+	primaryKeys []string // columns of the primary key, always selected with the fields param
+	columns     []string // columns of the model, the fallback orders are checked against
+	// primaryKeyType is the Go type of the primary key the ids are converted to, nil if it's unknown.
+	primaryKeyType reflect.Type
+	// defaultColumns are the columns selected by default if some fields are omitted, nil otherwise.
+	defaultColumns []string
+	// multiplying are the names of the has-many and many2many relations, joining them multiplies the rows.
+	multiplying []string
+	// unsortable are the params and the columns of the fields the field policy denies ordering by.
+	unsortable []string
+	// deletedAt is the column of the gorm.DeletedAt field, empty if the model isn't soft-deleted.
+	deletedAt string
+}
+
+// fieldMeta is the field configuration with the query param and the column resolved.
+type fieldMeta struct {
+	Field
+	param     string       // query param name
+	kind      reflect.Kind // Go kind of the field, reflect.Invalid for the table fields
+	valueType FieldType    // type of the field values, empty for the table fields
+	count     bool         // count of the relation, compared as an integer
+	citext    bool         // Postgres citext column, case-insensitive on its own
+	json      bool         // stored with the JSON serializer, filtered by the keys
+	nullable  bool         // the column could be NULL
+	// The column expressions are boxed once and shared by the requests.
+	column      interface{} // clause.Column of the field
+	lowerColumn interface{} // LOWER(column) expression of the field
+}
+
+// newFieldMeta computes the field metadata, the field type is nil for the table fields.
+func newFieldMeta(field Field, fieldType reflect.Type, table string) fieldMeta {
+	var column interface{} = clause.Column{Table: table, Name: field.Column}
+	switch {
+	case field.Expr != "":
+		column = clause.Expr{SQL: field.Expr}
+	case field.Having && field.Aggregate != "":
+		column = clause.Expr{SQL: field.Aggregate}
+	case field.Having:
+		// The aggregates are referred by the alias, which isn't qualified with the table.
+		column = clause.Column{Name: field.Column}
+	}
+	kind := reflect.Invalid
+	if fieldType != nil {
+		kind = fieldType.Kind()
+	}
+	fieldValueType := valueType(fieldType)
+	if field.INet {
+		fieldValueType = TypeINet
+	}
+	return fieldMeta{
+		Field:       field,
+		param:       filterParam(field),
+		kind:        kind,
+		valueType:   fieldValueType,
+		column:      column,
+		lowerColumn: clause.Expr{SQL: "LOWER(?)", Vars: []interface{}{column}},
+	}
+}
+
+var deletedAtType = reflect.TypeOf(gorm.DeletedAt{})
+
+// metaCacheKey identifies the metadata of the model, since the columns depend on the naming strategy.
+type metaCacheKey struct {
+	modelType reflect.Type
+	namer     schema.Namer
+}
+
+// MustRegister validates the models and caches their metadata for the naming strategy of the DB on startup,
+// so the first requests don't pay for parsing the schemas, and the misconfigured models fail the boot instead
+// of the requests. It panics with the errors of Validate or of parsing the schemas.
+// Example:
+//
+//	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+//	filter.MustRegister(db, &User{}, &Organization{})
+func MustRegister(db *gorm.DB, models ...interface{}) {
+	if err := Validate(models...); err != nil {
+		panic(err)
+	}
+	for _, model := range models {
+		statement := &gorm.Statement{DB: db}
+		_, _, ok := modelQueryMeta(model, db.NamingStrategy, &options{}, func() (*schema.Schema, error) {
+			err := statement.Parse(model)
+			return statement.Schema, err
+		})
+		if !ok {
+			panic(fmt.Sprintf("filter: can't parse the schema of %T", model))
+		}
+	}
+}
+
+// metaCache holds the metadata of the models. Models are static, so the entries are only dropped
+// when the model is registered with RegisterModel.
+var metaCache sync.Map
+
+// cachedModelMeta returns the metadata of the model, computing it from the parsed schema on the first use.
+// The returned metadata is shared and must not be modified.
+func cachedModelMeta(modelType reflect.Type, namer schema.Namer, parse func() (*schema.Schema, error)) (*modelMeta, bool) {
+	// Naming strategies of the non-comparable types can't be the cache keys.
+	if !comparableNamer(namer) {
+		return parseModelMeta(modelType, parse)
+	}
+
+	key := metaCacheKey{modelType: modelType, namer: namer}
+	if meta, ok := metaCache.Load(key); ok {
+		return meta.(*modelMeta), true
+	}
+	meta, ok := parseModelMeta(modelType, parse)
+	if ok {
+		metaCache.Store(key, meta)
+		registry.RLock()
+		_, registered := registry.models[modelType]
+		registry.RUnlock()
+		if !registered {
+			reportTagErrors(modelType)
+		}
+	}
+	return meta, ok
+}
+
+// comparableNamer reports whether the naming strategy could be the cache key,
+// checking the default strategy without the reflection.
+func comparableNamer(namer schema.Namer) bool {
+	if strategy, ok := namer.(schema.NamingStrategy); ok {
+		if strategy.NameReplacer == nil {
+			return true
+		}
+		if kind := reflect.TypeOf(strategy.NameReplacer).Kind(); kind == reflect.Ptr {
+			return true
+		}
+	}
+	return reflect.ValueOf(namer).Comparable()
+}
+
+// parseSchema parses the schema of the model for its metadata, stubbed in the tests to count the parses.
+var parseSchema = func(parse func() (*schema.Schema, error)) (*schema.Schema, error) {
+	return parse()
+}
+
+// parseModelMeta computes the metadata of the model fields from the parsed schema.
+func parseModelMeta(modelType reflect.Type, parse func() (*schema.Schema, error)) (*modelMeta, bool) {
+	modelSchema, err := parseSchema(parse)
+	if err != nil || modelSchema == nil {
+		return nil, false
+	}
+	fields := modelFields(modelType)
+	meta := &modelMeta{
+		modelType:   modelType,
+		fields:      make([]fieldMeta, 0, len(fields)),
+		primaryKeys: modelSchema.PrimaryFieldDBNames,
+		columns:     modelSchema.DBNames,
+	}
+	for name, relationship := range modelSchema.Relationships.Relations {
+		if relationship.Type == schema.HasMany || relationship.Type == schema.Many2Many {
+			meta.multiplying = append(meta.multiplying, name)
+		}
+	}
+	if primaryField := modelSchema.PrioritizedPrimaryField; primaryField != nil {
+		meta.primaryKeyType = indirectType(primaryField.FieldType)
+	}
+	for _, field := range fields {
+		structField, ok := lookupStructField(modelType, field.Name)
+		if ok && !structField.IsExported() {
+			continue
+		}
+		if ok && field.Param == "" {
+			field.Param = jsonName(structField)
+		}
+		if relationship, isRelation := modelSchema.Relationships.Relations[field.Name]; isRelation && field.Column == "" {
+			if count, ok := relationCountMeta(field, relationship); ok {
+				meta.fields = append(meta.fields, count)
+			}
+			continue
+		}
+		schemaField := modelSchema.LookUpField(field.Name)
+		if field.Column == "" {
+			// The fields ignored by gorm have no columns, so they can't be searched or filtered
+			// unless they're the expressions.
+			if schemaField != nil && schemaField.DBName != "" {
+				field.Column = schemaField.DBName
+			} else if field.Expr == "" {
+				continue
+			}
+		}
+		var fieldType reflect.Type
+		if ok {
+			fieldType = indirectType(structField.Type)
+		}
+		if schemaField != nil && inetDataType(schemaField.DataType) {
+			field.INet = true
+		}
+		if schemaField != nil && ltreeDataType(schemaField.DataType) {
+			field.LTree = true
+		}
+		if schemaField != nil && rangeDataType(schemaField.DataType) {
+			field.Range = true
+		}
+		resolved := newFieldMeta(field, fieldType, clause.CurrentTable)
+		resolved.citext = schemaField != nil && strings.EqualFold(string(schemaField.DataType), "citext")
+		resolved.json = jsonSerialized(schemaField)
+		resolved.nullable = schemaField != nil && !schemaField.NotNull && !schemaField.PrimaryKey
+		meta.fields = append(meta.fields, resolved)
+	}
+	for _, field := range modelSchema.Fields {
+		if field.FieldType == deletedAtType {
+			meta.deletedAt = field.DBName
+			break
+		}
+	}
+	if omitsFields(modelType) {
+		meta.defaultColumns = make([]string, 0, len(modelSchema.DBNames))
+		for _, column := range modelSchema.DBNames {
+			if !slices.ContainsFunc(meta.fields, func(field fieldMeta) bool { return field.Omit && field.Column == column }) {
+				meta.defaultColumns = append(meta.defaultColumns, column)
+			}
+		}
+	}
+	return meta, true
+}
+
+// tableMeta computes the metadata of the table fields configured with WithTable.
+func tableMeta(table string, fields Fields, namer schema.Namer) *modelMeta {
+	meta := &modelMeta{fields: make([]fieldMeta, 0, len(fields)), columns: make([]string, 0, len(fields))}
+	for _, field := range fields {
+		if field.Column == "" {
+			field.Column = namer.ColumnName(table, field.Name)
+		}
+		meta.fields = append(meta.fields, newFieldMeta(field, nil, table))
+		meta.columns = append(meta.columns, field.Column)
+	}
+	return meta
+}
+
+// structType returns the struct type of the model, unwrapping the pointers, slices and arrays,
+// nil if the model isn't a struct.
+func structType(model interface{}) reflect.Type {
+	modelType := reflect.TypeOf(model)
+	for modelType != nil {
+		switch modelType.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array:
+			modelType = modelType.Elem()
+		case reflect.Struct:
+			return modelType
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// jsonName returns the name of the field in the json tag, empty if it's not tagged or skipped.
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// omitCache holds whether the models have omitted fields, so the models without them aren't parsed
+// to build the default selection.
+var omitCache sync.Map
+
+// omitsFields reports whether any field of the model is omitted from the default selection.
+func omitsFields(modelType reflect.Type) bool {
+	if omits, ok := omitCache.Load(modelType); ok {
+		return omits.(bool)
+	}
+	omits := slices.ContainsFunc(modelFields(modelType), func(field Field) bool { return field.Omit })
+	omitCache.Store(modelType, omits)
+	return omits
+}
+
+// exprCache holds whether the models have the expression fields, so the models without them aren't parsed
+// to be ordered.
+var exprCache sync.Map
+
+// expressesFields reports whether any field of the model is the expression.
+func expressesFields(modelType reflect.Type) bool {
+	if expresses, ok := exprCache.Load(modelType); ok {
+		return expresses.(bool)
+	}
+	expresses := slices.ContainsFunc(modelFields(modelType), func(field Field) bool { return field.Expr != "" })
+	exprCache.Store(modelType, expresses)
+	return expresses
+}
+
+// defaultCache holds whether the models have the default filter values, so the models without them aren't
+// parsed unless there is anything to filter.
+var defaultCache sync.Map
+
+// defaultsFields reports whether any filterable field of the model has the default value.
+func defaultsFields(modelType reflect.Type) bool {
+	if defaults, ok := defaultCache.Load(modelType); ok {
+		return defaults.(bool)
+	}
+	defaults := slices.ContainsFunc(modelFields(modelType), hasDefault)
+	defaultCache.Store(modelType, defaults)
+	return defaults
+}
+
+// invalidateMetaCache drops the cached metadata of the model type.
+func invalidateMetaCache(modelType reflect.Type) {
+	omitCache.Delete(modelType)
+	exprCache.Delete(modelType)
+	defaultCache.Delete(modelType)
+	metaCache.Range(func(key, _ interface{}) bool {
+		if key.(metaCacheKey).modelType == modelType {
+			metaCache.Delete(key)
+		}
+		return true
+	})
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// TestMetaCacheNamingStrategies is a test for caching the metadata per naming strategy.
+func (s *TestSuite) TestMetaCacheNamingStrategies() {
+	_, meta, ok := queryMeta(s.db.Model(&User{}), newOptions(nil))
+	s.Require().True(ok)
+	s.Equal("full_name", meta.fields[2].Column)
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: s.db.ConnPool}), &gorm.Config{
+		NamingStrategy: schema.NamingStrategy{NameReplacer: strings.NewReplacer("Full", "Display")},
+	})
+	s.Require().NoError(err)
+	_, meta, ok = queryMeta(db.Model(&User{}), newOptions(nil))
+	s.Require().True(ok)
+	s.Equal("display_name", meta.fields[2].Column)
+}
+
+// TestModelMeta is a test for the metadata computed from the model tags.
+func (s *TestSuite) TestModelMeta() {
+	type Product struct {
+		Id    uint     `filter:"param:id;filterable;sortable"`
+		Title string   `filter:"param:name;searchable;sortable"`
+		Price *float64 `filter:"filterable"`
+	}
+
+	_, meta, ok := defaultQueryMeta(&Product{}, newOptions(nil))
+	s.Require().True(ok)
+	s.Require().Len(meta.fields, 3)
+	s.Equal("id", meta.fields[0].param)
+	s.True(meta.fields[0].Sortable)
+	s.Equal(reflect.Uint, meta.fields[0].kind)
+	s.Equal("name", meta.fields[1].param)
+	s.Equal("title", meta.fields[1].Column)
+	s.Equal("price", meta.fields[2].param)
+	s.False(meta.fields[2].Sortable)
+	s.Equal(reflect.Float64, meta.fields[2].kind)
+}
+
+// TestMetaSkippedWithoutConditions is a test for not introspecting the model when there is nothing
+// to search or filter.
+func (s *TestSuite) TestMetaSkippedWithoutConditions() {
+	invalidateMetaCache(reflect.TypeOf(User{}))
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?search=John&filter=login:sampleUser&page=2", nil)}
+
+	db := FilterByQuery(&ctx, PAGINATE|ORDER_BY)(s.db.Model(&User{}))
+	s.Nil(db.Statement.Schema)
+	metaCache.Range(func(key, _ interface{}) bool {
+		s.NotEqual(reflect.TypeOf(User{}), key.(metaCacheKey).modelType)
+		return true
+	})
+}
+
+// TestFieldsCacheConcurrent is a test for filtering the same model concurrently, run it with -race.
+func (s *TestSuite) TestMetaCacheConcurrent() {
+	invalidateMetaCache(reflect.TypeOf(User{}))
+	dryRun := s.db.Session(&gorm.Session{DryRun: true})
+	statements := make([]string, 16)
+
+	var wg sync.WaitGroup
+	for i := range statements {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var users []User
+			ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?filter=login:sampleUser&search=John", nil)}
+			statements[i] = dryRun.Model(&User{}).Scopes(FilterByQuery(&ctx, ALL)).Find(&users).Statement.SQL.String()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, statement := range statements {
+		s.Equal(statements[0], statement)
+	}
+}
+
+func BenchmarkModelMeta(b *testing.B) {
+	modelType := reflect.TypeOf(User{})
+	namer := schema.NamingStrategy{}
+	db := newBenchmarkDB(b)
+
+	b.Run("schema", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			parseModelMeta(modelType, func() (*schema.Schema, error) {
+				return schema.Parse(&User{}, &sync.Map{}, namer)
+			})
+		}
+	})
+	b.Run("statement", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			stmt := db.Model(&User{}).Statement
+			parseModelMeta(modelType, func() (*schema.Schema, error) {
+				err := stmt.Parse(stmt.Model)
+				return stmt.Schema, err
+			})
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			queryMeta(db.Model(&User{}), newOptions(nil))
+		}
+	})
+}
+
+// TestModelMetaIgnoredFields is a test for skipping the unexported fields and the fields ignored by gorm.
+func (s *TestSuite) TestModelMetaIgnoredFields() {
+	type Session struct {
+		Id       uint   `filter:"param:id;filterable"`
+		Token    string `filter:"param:token;filterable;searchable"`
+		internal string `filter:"filterable;searchable"`
+		Temp     string `gorm:"-" filter:"param:temp;filterable;searchable"`
+	}
+
+	_, meta, ok := defaultQueryMeta(&Session{}, newOptions(nil))
+	s.Require().True(ok)
+	s.Require().Len(meta.fields, 2)
+	s.Equal("token", meta.fields[1].Column)
+
+	var sessions []Session
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/sessions?search=abc&filter=token:abc&filter=temp:1&filter=internal:1", nil)}
+	s.NotPanics(func() {
+		statement := s.db.Session(&gorm.Session{DryRun: true}).Model(&Session{}).
+			Scopes(FilterByQuery(&ctx, SEARCH|FILTER)).Find(&sessions).Statement
+		s.Equal(`SELECT * FROM "sessions" WHERE "sessions"."token" ILIKE $1 AND "sessions"."token" = $2`,
+			statement.SQL.String())
+	})
+}
+
+// TestMustRegister is a test for serving the scopes from the metadata cached on startup.
+func (s *TestSuite) TestMustRegister() {
+	type Warehouse struct {
+		Id   uint   `filter:"param:id;filterable"`
+		City string `filter:"filterable;searchable"`
+	}
+	parsed := 0
+	original := parseSchema
+	parseSchema = func(parse func() (*schema.Schema, error)) (*schema.Schema, error) {
+		parsed++
+		return parse()
+	}
+	defer func() { parseSchema = original }()
+
+	MustRegister(s.db, &Warehouse{})
+	warm := parsed
+	s.Positive(warm)
+
+	var warehouses []Warehouse
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/warehouses?filter=city:Oslo", nil)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "warehouses" WHERE "warehouses"."city" = \$1$`).
+		WithArgs("Oslo").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "city"}))
+	err := s.db.Model(&Warehouse{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&warehouses).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+	s.Equal(warm, parsed)
+
+	type Depot struct {
+		Id   uint
+		City string `filter:"serchable"`
+	}
+	s.PanicsWithError(`filter: invalid field Depot.City: unknown token "serchable"`, func() {
+		MustRegister(s.db, &Depot{})
+	})
+}
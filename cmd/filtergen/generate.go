@@ -0,0 +1,451 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gorm.io/gorm/schema"
+)
+
+// defaultOutput is the name of the generated file.
+const defaultOutput = "filters_gen.go"
+
+// generatedComment marks the generated files, which aren't parsed for the models.
+const generatedComment = "// Code generated by filtergen. DO NOT EDIT."
+
+// The tag syntax is the same as the one of the filter package.
+var (
+	paramNameRegexp   = regexp.MustCompile(`(?m)param:(\w{1,}).*`)
+	operatorRegexp    = regexp.MustCompile(`(?:^|;)op:(\w+)`)
+	boundParamsRegexp = regexp.MustCompile(`(?:^|;)params:([^;]*)`)
+)
+
+// boundOperators are the names of the `op` tag.
+var boundOperators = []string{"eq", "ne", "gt", "gte", "lt", "lte", "like"}
+
+// initialisms are the words spelled in the upper case in the Go names.
+var initialisms = map[string]bool{
+	"api": true, "html": true, "http": true, "id": true, "ip": true, "json": true,
+	"sql": true, "uri": true, "url": true, "uuid": true,
+}
+
+// operator is the filter operator of the typed setters.
+type operator struct {
+	suffix string
+	symbol string
+	doc    string
+}
+
+var (
+	eq           = operator{"Eq", ":", "equal to the value"}
+	ne           = operator{"Ne", "!=", "not equal to the value"}
+	gt           = operator{"Gt", ">", "greater than the value"}
+	gte          = operator{"Gte", ">=", "greater than or equal to the value"}
+	lt           = operator{"Lt", "<", "less than the value"}
+	lte          = operator{"Lte", "<=", "less than or equal to the value"}
+	like         = operator{"Like", "~", "containing the value"}
+	in           = operator{"In", "<<", "in the network of the value"}
+	descendantOf = operator{"DescendantOf", "<@", "under the path of the value"}
+	ancestorOf   = operator{"AncestorOf", "@>", "above the path of the value"}
+	overlaps     = operator{"Overlaps", "&&", "overlapping the range of the value"}
+)
+
+// valueKind is the kind of the field values, it defines the operators and the formatting of the values.
+type valueKind int
+
+const (
+	kindString valueKind = iota
+	kindNamedString
+	kindNumber
+	kindBool
+	kindTime
+	kindOther
+)
+
+// valueType is the Go type of the setter values.
+type valueType struct {
+	expr       string // type expression, e.g. time.Time
+	kind       valueKind
+	importPath string // package of the type, empty for the builtin and the local types
+}
+
+// format returns the expression formatting the value as the filter param value.
+func (t valueType) format() string {
+	switch t.kind {
+	case kindString:
+		return "value"
+	case kindNamedString:
+		return "string(value)"
+	case kindTime:
+		return "value.Format(time.RFC3339Nano)"
+	default:
+		return "fmt.Sprint(value)"
+	}
+}
+
+// setter is the typed setter of the filter condition.
+type setter struct {
+	method   string
+	constant string
+	operator string
+	doc      string
+	value    valueType
+}
+
+// model is the model with the filterable fields.
+type model struct {
+	name     string
+	params   [][2]string // constant names and the params
+	setters  []setter
+	declared map[string]bool
+}
+
+func (m *model) addParam(name, param string) string {
+	constant := m.name + "Param" + name
+	if !m.declared[constant] {
+		m.declared[constant] = true
+		m.params = append(m.params, [2]string{constant, param})
+	}
+	return constant
+}
+
+// packageFile is the parsed source file of the models.
+type packageFile struct {
+	file    *ast.File
+	imports map[string]string // import paths by the package names
+}
+
+// generate generates the source of the filter conditions of the models in the package directory.
+func generate(dir, output string) ([]byte, error) {
+	packageName, files, err := parsePackage(dir, output)
+	if err != nil {
+		return nil, err
+	}
+	types := make(map[string]ast.Expr)
+	for _, file := range files {
+		for _, decl := range file.file.Decls {
+			if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.TYPE {
+				for _, spec := range genDecl.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					types[typeSpec.Name.Name] = typeSpec.Type
+				}
+			}
+		}
+	}
+
+	var models []*model
+	for _, file := range files {
+		for _, decl := range file.file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				if !ok || !typeSpec.Name.IsExported() || typeSpec.TypeParams != nil {
+					continue
+				}
+				m := &model{name: typeSpec.Name.Name, declared: make(map[string]bool)}
+				for _, field := range structType.Fields.List {
+					addFieldSetters(m, field, file, types)
+				}
+				if len(m.setters) > 0 {
+					models = append(models, m)
+				}
+			}
+		}
+	}
+	if len(models) == 0 {
+		return nil, errors.New("no models with the filterable fields in " + dir)
+	}
+	return render(packageName, models)
+}
+
+// parsePackage parses the source files of the package, except the tests and the generated files.
+func parsePackage(dir, output string) (string, []packageFile, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", nil, err
+	}
+	slices.Sort(paths)
+	var (
+		packageName string
+		files       []packageFile
+	)
+	fileSet := token.NewFileSet()
+	for _, filePath := range paths {
+		if strings.HasSuffix(filePath, "_test.go") || filepath.Base(filePath) == output {
+			continue
+		}
+		source, err := os.ReadFile(filePath)
+		if err != nil {
+			return "", nil, err
+		}
+		if bytes.HasPrefix(source, []byte(generatedComment)) {
+			continue
+		}
+		file, err := parser.ParseFile(fileSet, filePath, source, parser.SkipObjectResolution)
+		if err != nil {
+			return "", nil, err
+		}
+		if packageName != "" && file.Name.Name != packageName {
+			return "", nil, fmt.Errorf("found packages %s and %s in %s", packageName, file.Name.Name, dir)
+		}
+		packageName = file.Name.Name
+		imports := make(map[string]string, len(file.Imports))
+		for _, spec := range file.Imports {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+			name := path.Base(importPath)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			imports[name] = importPath
+		}
+		files = append(files, packageFile{file: file, imports: imports})
+	}
+	if packageName == "" {
+		return "", nil, errors.New("no Go files in " + dir)
+	}
+	return packageName, files, nil
+}
+
+// addFieldSetters adds the setters of the filterable field and its bound params to the model.
+func addFieldSetters(m *model, field *ast.Field, file packageFile, types map[string]ast.Expr) {
+	if len(field.Names) == 0 || field.Tag == nil {
+		return
+	}
+	tagValue, _ := strconv.Unquote(field.Tag.Value)
+	tag := reflect.StructTag(tagValue)
+	filterTag := tag.Get("filter")
+	gormSettings := schema.ParseTagSetting(tag.Get("gorm"), ";")
+	if filterTag == "" || gormSettings["-"] != "" || gormSettings["SERIALIZER"] != "" {
+		return
+	}
+	value, ok := resolveType(field.Type, file, types)
+	if !ok {
+		return
+	}
+	dataType := strings.ToLower(gormSettings["TYPE"])
+
+	for _, fieldName := range field.Names {
+		if !fieldName.IsExported() {
+			continue
+		}
+		if strings.Contains(filterTag, "filterable") {
+			param := fieldParam(fieldName.Name, filterTag, tag, gormSettings)
+			name := goName(param)
+			constant := m.addParam(name, param)
+			if match := operatorRegexp.FindStringSubmatch(filterTag); len(match) == 2 {
+				if slices.Contains(boundOperators, match[1]) {
+					m.setters = append(m.setters, boundSetter(name, constant, param, value))
+				}
+			} else {
+				for _, op := range fieldOperators(filterTag, dataType, value) {
+					m.setters = append(m.setters, setter{
+						method:   name + op.suffix,
+						constant: constant,
+						operator: op.symbol,
+						doc:      "filters by " + param + " " + op.doc + ".",
+						value:    value,
+					})
+				}
+			}
+		}
+		if match := boundParamsRegexp.FindStringSubmatch(filterTag); len(match) == 2 {
+			for _, bound := range strings.Split(match[1], ",") {
+				param, op, _ := strings.Cut(bound, ":")
+				if param == "" || !slices.Contains(boundOperators, op) {
+					continue
+				}
+				name := goName(param)
+				m.setters = append(m.setters, boundSetter(name, m.addParam(name, param), param, value))
+			}
+		}
+	}
+}
+
+// boundSetter returns the setter of the param bound to the operator, filtered with the equality syntax.
+func boundSetter(name, constant, param string, value valueType) setter {
+	return setter{method: name, constant: constant, operator: eq.symbol, doc: "filters by the " + param + " param.", value: value}
+}
+
+// fieldOperators returns the operators of the field setters.
+func fieldOperators(filterTag, dataType string, value valueType) []operator {
+	switch {
+	case strings.Contains(filterTag, "inet") || dataType == "inet" || dataType == "cidr":
+		return []operator{eq, ne, gt, gte, lt, lte, in}
+	case strings.Contains(filterTag, "ltree") || dataType == "ltree":
+		return []operator{eq, ne, descendantOf, ancestorOf}
+	case strings.Contains(filterTag, "range") || dataType == "tstzrange":
+		return []operator{overlaps}
+	case value.kind == kindString || value.kind == kindNamedString:
+		return []operator{eq, ne, gt, gte, lt, lte, like}
+	case value.kind == kindNumber || value.kind == kindTime:
+		return []operator{eq, ne, gt, gte, lt, lte}
+	default:
+		return []operator{eq, ne}
+	}
+}
+
+// fieldParam returns the filter param of the field, named after the column of the default naming
+// strategy unless it's set with the `param` or the json tag.
+func fieldParam(fieldName, filterTag string, tag reflect.StructTag, gormSettings map[string]string) string {
+	if match := paramNameRegexp.FindStringSubmatch(filterTag); len(match) == 2 {
+		return match[1]
+	}
+	if name, _, _ := strings.Cut(tag.Get("json"), ","); name != "" && name != "-" {
+		return name
+	}
+	if column := gormSettings["COLUMN"]; column != "" {
+		return column
+	}
+	return schema.NamingStrategy{}.ColumnName("", fieldName)
+}
+
+// resolveType resolves the type of the field values, it's not ok for the relations and the composite types.
+func resolveType(expr ast.Expr, file packageFile, types map[string]ast.Expr) (valueType, bool) {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return resolveType(t.X, file, types)
+	case *ast.Ident:
+		if kind, ok := basicKind(t.Name); ok {
+			return valueType{expr: t.Name, kind: kind}, true
+		}
+		underlying, ok := types[t.Name]
+		if !ok {
+			return valueType{}, false
+		}
+		if ident, ok := underlying.(*ast.Ident); ok {
+			if kind, ok := basicKind(ident.Name); ok {
+				if kind == kindString {
+					kind = kindNamedString
+				}
+				return valueType{expr: t.Name, kind: kind}, true
+			}
+		}
+		return valueType{}, false
+	case *ast.SelectorExpr:
+		packageIdent, ok := t.X.(*ast.Ident)
+		if !ok || file.imports[packageIdent.Name] == "" {
+			return valueType{}, false
+		}
+		importPath := file.imports[packageIdent.Name]
+		value := valueType{expr: packageIdent.Name + "." + t.Sel.Name, kind: kindOther, importPath: importPath}
+		if importPath == "time" && t.Sel.Name == "Time" {
+			value.kind = kindTime
+		}
+		if importPath == "gorm.io/gorm" && t.Sel.Name == "DeletedAt" {
+			return valueType{}, false
+		}
+		return value, true
+	default:
+		return valueType{}, false
+	}
+}
+
+// basicKind returns the kind of the builtin type.
+func basicKind(name string) (valueKind, bool) {
+	switch name {
+	case "string":
+		return kindString, true
+	case "bool":
+		return kindBool, true
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64",
+		"float32", "float64", "byte", "rune":
+		return kindNumber, true
+	default:
+		return 0, false
+	}
+}
+
+// goName returns the exported Go name of the param, e.g. UserID for user_id.
+func goName(param string) string {
+	var name strings.Builder
+	words := strings.FieldsFunc(param, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+	for _, word := range words {
+		if initialisms[strings.ToLower(word)] {
+			name.WriteString(strings.ToUpper(word))
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		name.WriteString(string(runes))
+	}
+	if name.Len() == 0 || unicode.IsDigit([]rune(name.String())[0]) {
+		return "Param" + name.String()
+	}
+	return name.String()
+}
+
+// render renders and formats the source of the models.
+func render(packageName string, models []*model) ([]byte, error) {
+	// The standard library imports are grouped before the other ones.
+	var standard, other []string
+	for _, m := range models {
+		for _, s := range m.setters {
+			if s.value.format() == "fmt.Sprint(value)" {
+				standard = append(standard, "fmt")
+			}
+			if s.value.kind == kindTime {
+				standard = append(standard, "time")
+			}
+			if first, _, _ := strings.Cut(s.value.importPath, "/"); strings.Contains(first, ".") {
+				other = append(other, s.value.importPath)
+			} else if s.value.importPath != "" {
+				standard = append(standard, s.value.importPath)
+			}
+		}
+	}
+	slices.Sort(standard)
+	slices.Sort(other)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%s\n\npackage %s\n\nimport (\n", generatedComment, packageName)
+	for _, importPath := range slices.Compact(standard) {
+		fmt.Fprintf(&b, "\t%q\n", importPath)
+	}
+	b.WriteString("\n\tfilter \"github.com/ActiveChooN/gin-gorm-filter\"\n\t\"gorm.io/gorm\"\n")
+	for _, importPath := range slices.Compact(other) {
+		fmt.Fprintf(&b, "\t%q\n", importPath)
+	}
+	b.WriteString(")\n")
+	for _, m := range models {
+		fmt.Fprintf(&b, "\n// Filter params of %s.\nconst (\n", m.name)
+		for _, param := range m.params {
+			fmt.Fprintf(&b, "\t%s = %q\n", param[0], param[1])
+		}
+		b.WriteString(")\n")
+
+		filters := m.name + "Filters"
+		fmt.Fprintf(&b, "\n// %s builds the filter conditions of %s.\ntype %s struct {\n\tconditions []filter.Condition\n}\n", filters, m.name, filters)
+		fmt.Fprintf(&b, "\n// Conditions returns the filter conditions.\nfunc (f *%s) Conditions() []filter.Condition {\n\treturn f.conditions\n}\n", filters)
+		fmt.Fprintf(&b, "\n// Scope filters the DB request with the conditions, see filter.FilterByConditions.\n"+
+			"func (f *%s) Scope(opts ...filter.Option) func(db *gorm.DB) *gorm.DB {\n\treturn filter.FilterByConditions(f.conditions, opts...)\n}\n", filters)
+		for _, s := range m.setters {
+			fmt.Fprintf(&b, "\n// %s %s\nfunc (f *%s) %s(value %s) *%s {\n", s.method, s.doc, filters, s.method, s.value.expr, filters)
+			fmt.Fprintf(&b, "\tf.conditions = append(f.conditions, filter.Condition{Param: %s, Operator: %q, Value: %s})\n\treturn f\n}\n",
+				s.constant, s.operator, s.value.format())
+		}
+	}
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerate is a test for the generated source of the example models, checked in as the golden file.
+func TestGenerate(t *testing.T) {
+	dir := filepath.Join("internal", "example")
+	source, err := generate(dir, defaultOutput)
+	require.NoError(t, err)
+
+	golden, err := os.ReadFile(filepath.Join(dir, defaultOutput))
+	require.NoError(t, err)
+	require.Equal(t, string(golden), string(source), "run go generate ./cmd/filtergen/internal/example")
+}
+
+// TestGenerateNoModels is a test for the package without the filterable models.
+func TestGenerateNoModels(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "models.go"), []byte("package models\n\ntype User struct {\n\tID uint\n}\n"), 0o644))
+	_, err := generate(dir, defaultOutput)
+	require.EqualError(t, err, "no models with the filterable fields in "+dir)
+}
+
+// TestGoName is a test for the Go names of the params.
+func TestGoName(t *testing.T) {
+	tests := map[string]string{
+		"login":          "Login",
+		"id":             "ID",
+		"user_id":        "UserID",
+		"created_after":  "CreatedAfter",
+		"ip.address":     "IPAddress",
+		"2fa":            "Param2fa",
+		"organizationId": "OrganizationId",
+	}
+	for param, expected := range tests {
+		require.Equal(t, expected, goName(param), param)
+	}
+}
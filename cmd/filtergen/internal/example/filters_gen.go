@@ -0,0 +1,367 @@
+// Code generated by filtergen. DO NOT EDIT.
+
+package example
+
+import (
+	"fmt"
+	"time"
+
+	filter "github.com/ActiveChooN/gin-gorm-filter"
+	"gorm.io/gorm"
+)
+
+// Filter params of User.
+const (
+	UserParamID            = "id"
+	UserParamLogin         = "login"
+	UserParamRole          = "role"
+	UserParamIsActive      = "is_active"
+	UserParamRating        = "rating"
+	UserParamAddress       = "address"
+	UserParamCreatedAt     = "created_at"
+	UserParamCreatedAfter  = "created_after"
+	UserParamCreatedBefore = "created_before"
+)
+
+// UserFilters builds the filter conditions of User.
+type UserFilters struct {
+	conditions []filter.Condition
+}
+
+// Conditions returns the filter conditions.
+func (f *UserFilters) Conditions() []filter.Condition {
+	return f.conditions
+}
+
+// Scope filters the DB request with the conditions, see filter.FilterByConditions.
+func (f *UserFilters) Scope(opts ...filter.Option) func(db *gorm.DB) *gorm.DB {
+	return filter.FilterByConditions(f.conditions, opts...)
+}
+
+// IDEq filters by id equal to the value.
+func (f *UserFilters) IDEq(value uint) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamID, Operator: ":", Value: fmt.Sprint(value)})
+	return f
+}
+
+// IDNe filters by id not equal to the value.
+func (f *UserFilters) IDNe(value uint) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamID, Operator: "!=", Value: fmt.Sprint(value)})
+	return f
+}
+
+// IDGt filters by id greater than the value.
+func (f *UserFilters) IDGt(value uint) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamID, Operator: ">", Value: fmt.Sprint(value)})
+	return f
+}
+
+// IDGte filters by id greater than or equal to the value.
+func (f *UserFilters) IDGte(value uint) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamID, Operator: ">=", Value: fmt.Sprint(value)})
+	return f
+}
+
+// IDLt filters by id less than the value.
+func (f *UserFilters) IDLt(value uint) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamID, Operator: "<", Value: fmt.Sprint(value)})
+	return f
+}
+
+// IDLte filters by id less than or equal to the value.
+func (f *UserFilters) IDLte(value uint) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamID, Operator: "<=", Value: fmt.Sprint(value)})
+	return f
+}
+
+// LoginEq filters by login equal to the value.
+func (f *UserFilters) LoginEq(value string) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamLogin, Operator: ":", Value: value})
+	return f
+}
+
+// LoginNe filters by login not equal to the value.
+func (f *UserFilters) LoginNe(value string) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamLogin, Operator: "!=", Value: value})
+	return f
+}
+
+// LoginGt filters by login greater than the value.
+func (f *UserFilters) LoginGt(value string) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamLogin, Operator: ">", Value: value})
+	return f
+}
+
+// LoginGte filters by login greater than or equal to the value.
+func (f *UserFilters) LoginGte(value string) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamLogin, Operator: ">=", Value: value})
+	return f
+}
+
+// LoginLt filters by login less than the value.
+func (f *UserFilters) LoginLt(value string) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamLogin, Operator: "<", Value: value})
+	return f
+}
+
+// LoginLte filters by login less than or equal to the value.
+func (f *UserFilters) LoginLte(value string) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamLogin, Operator: "<=", Value: value})
+	return f
+}
+
+// LoginLike filters by login containing the value.
+func (f *UserFilters) LoginLike(value string) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamLogin, Operator: "~", Value: value})
+	return f
+}
+
+// RoleEq filters by role equal to the value.
+func (f *UserFilters) RoleEq(value Role) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamRole, Operator: ":", Value: string(value)})
+	return f
+}
+
+// RoleNe filters by role not equal to the value.
+func (f *UserFilters) RoleNe(value Role) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamRole, Operator: "!=", Value: string(value)})
+	return f
+}
+
+// RoleGt filters by role greater than the value.
+func (f *UserFilters) RoleGt(value Role) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamRole, Operator: ">", Value: string(value)})
+	return f
+}
+
+// RoleGte filters by role greater than or equal to the value.
+func (f *UserFilters) RoleGte(value Role) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamRole, Operator: ">=", Value: string(value)})
+	return f
+}
+
+// RoleLt filters by role less than the value.
+func (f *UserFilters) RoleLt(value Role) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamRole, Operator: "<", Value: string(value)})
+	return f
+}
+
+// RoleLte filters by role less than or equal to the value.
+func (f *UserFilters) RoleLte(value Role) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamRole, Operator: "<=", Value: string(value)})
+	return f
+}
+
+// RoleLike filters by role containing the value.
+func (f *UserFilters) RoleLike(value Role) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamRole, Operator: "~", Value: string(value)})
+	return f
+}
+
+// IsActiveEq filters by is_active equal to the value.
+func (f *UserFilters) IsActiveEq(value bool) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamIsActive, Operator: ":", Value: fmt.Sprint(value)})
+	return f
+}
+
+// IsActiveNe filters by is_active not equal to the value.
+func (f *UserFilters) IsActiveNe(value bool) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamIsActive, Operator: "!=", Value: fmt.Sprint(value)})
+	return f
+}
+
+// RatingEq filters by rating equal to the value.
+func (f *UserFilters) RatingEq(value float64) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamRating, Operator: ":", Value: fmt.Sprint(value)})
+	return f
+}
+
+// RatingNe filters by rating not equal to the value.
+func (f *UserFilters) RatingNe(value float64) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamRating, Operator: "!=", Value: fmt.Sprint(value)})
+	return f
+}
+
+// RatingGt filters by rating greater than the value.
+func (f *UserFilters) RatingGt(value float64) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamRating, Operator: ">", Value: fmt.Sprint(value)})
+	return f
+}
+
+// RatingGte filters by rating greater than or equal to the value.
+func (f *UserFilters) RatingGte(value float64) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamRating, Operator: ">=", Value: fmt.Sprint(value)})
+	return f
+}
+
+// RatingLt filters by rating less than the value.
+func (f *UserFilters) RatingLt(value float64) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamRating, Operator: "<", Value: fmt.Sprint(value)})
+	return f
+}
+
+// RatingLte filters by rating less than or equal to the value.
+func (f *UserFilters) RatingLte(value float64) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamRating, Operator: "<=", Value: fmt.Sprint(value)})
+	return f
+}
+
+// AddressEq filters by address equal to the value.
+func (f *UserFilters) AddressEq(value string) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamAddress, Operator: ":", Value: value})
+	return f
+}
+
+// AddressNe filters by address not equal to the value.
+func (f *UserFilters) AddressNe(value string) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamAddress, Operator: "!=", Value: value})
+	return f
+}
+
+// AddressGt filters by address greater than the value.
+func (f *UserFilters) AddressGt(value string) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamAddress, Operator: ">", Value: value})
+	return f
+}
+
+// AddressGte filters by address greater than or equal to the value.
+func (f *UserFilters) AddressGte(value string) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamAddress, Operator: ">=", Value: value})
+	return f
+}
+
+// AddressLt filters by address less than the value.
+func (f *UserFilters) AddressLt(value string) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamAddress, Operator: "<", Value: value})
+	return f
+}
+
+// AddressLte filters by address less than or equal to the value.
+func (f *UserFilters) AddressLte(value string) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamAddress, Operator: "<=", Value: value})
+	return f
+}
+
+// AddressIn filters by address in the network of the value.
+func (f *UserFilters) AddressIn(value string) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamAddress, Operator: "<<", Value: value})
+	return f
+}
+
+// CreatedAtEq filters by created_at equal to the value.
+func (f *UserFilters) CreatedAtEq(value time.Time) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamCreatedAt, Operator: ":", Value: value.Format(time.RFC3339Nano)})
+	return f
+}
+
+// CreatedAtNe filters by created_at not equal to the value.
+func (f *UserFilters) CreatedAtNe(value time.Time) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamCreatedAt, Operator: "!=", Value: value.Format(time.RFC3339Nano)})
+	return f
+}
+
+// CreatedAtGt filters by created_at greater than the value.
+func (f *UserFilters) CreatedAtGt(value time.Time) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamCreatedAt, Operator: ">", Value: value.Format(time.RFC3339Nano)})
+	return f
+}
+
+// CreatedAtGte filters by created_at greater than or equal to the value.
+func (f *UserFilters) CreatedAtGte(value time.Time) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamCreatedAt, Operator: ">=", Value: value.Format(time.RFC3339Nano)})
+	return f
+}
+
+// CreatedAtLt filters by created_at less than the value.
+func (f *UserFilters) CreatedAtLt(value time.Time) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamCreatedAt, Operator: "<", Value: value.Format(time.RFC3339Nano)})
+	return f
+}
+
+// CreatedAtLte filters by created_at less than or equal to the value.
+func (f *UserFilters) CreatedAtLte(value time.Time) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamCreatedAt, Operator: "<=", Value: value.Format(time.RFC3339Nano)})
+	return f
+}
+
+// CreatedAfter filters by the created_after param.
+func (f *UserFilters) CreatedAfter(value time.Time) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamCreatedAfter, Operator: ":", Value: value.Format(time.RFC3339Nano)})
+	return f
+}
+
+// CreatedBefore filters by the created_before param.
+func (f *UserFilters) CreatedBefore(value time.Time) *UserFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: UserParamCreatedBefore, Operator: ":", Value: value.Format(time.RFC3339Nano)})
+	return f
+}
+
+// Filter params of Category.
+const (
+	CategoryParamPath = "path"
+)
+
+// CategoryFilters builds the filter conditions of Category.
+type CategoryFilters struct {
+	conditions []filter.Condition
+}
+
+// Conditions returns the filter conditions.
+func (f *CategoryFilters) Conditions() []filter.Condition {
+	return f.conditions
+}
+
+// Scope filters the DB request with the conditions, see filter.FilterByConditions.
+func (f *CategoryFilters) Scope(opts ...filter.Option) func(db *gorm.DB) *gorm.DB {
+	return filter.FilterByConditions(f.conditions, opts...)
+}
+
+// PathEq filters by path equal to the value.
+func (f *CategoryFilters) PathEq(value string) *CategoryFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: CategoryParamPath, Operator: ":", Value: value})
+	return f
+}
+
+// PathNe filters by path not equal to the value.
+func (f *CategoryFilters) PathNe(value string) *CategoryFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: CategoryParamPath, Operator: "!=", Value: value})
+	return f
+}
+
+// PathDescendantOf filters by path under the path of the value.
+func (f *CategoryFilters) PathDescendantOf(value string) *CategoryFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: CategoryParamPath, Operator: "<@", Value: value})
+	return f
+}
+
+// PathAncestorOf filters by path above the path of the value.
+func (f *CategoryFilters) PathAncestorOf(value string) *CategoryFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: CategoryParamPath, Operator: "@>", Value: value})
+	return f
+}
+
+// Filter params of Booking.
+const (
+	BookingParamDuring = "during"
+)
+
+// BookingFilters builds the filter conditions of Booking.
+type BookingFilters struct {
+	conditions []filter.Condition
+}
+
+// Conditions returns the filter conditions.
+func (f *BookingFilters) Conditions() []filter.Condition {
+	return f.conditions
+}
+
+// Scope filters the DB request with the conditions, see filter.FilterByConditions.
+func (f *BookingFilters) Scope(opts ...filter.Option) func(db *gorm.DB) *gorm.DB {
+	return filter.FilterByConditions(f.conditions, opts...)
+}
+
+// DuringOverlaps filters by during overlapping the range of the value.
+func (f *BookingFilters) DuringOverlaps(value string) *BookingFilters {
+	f.conditions = append(f.conditions, filter.Condition{Param: BookingParamDuring, Operator: "&&", Value: value})
+	return f
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package example
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	filter "github.com/ActiveChooN/gin-gorm-filter"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// TestScope is a test for the generated scope building the same statement as the filter params.
+func TestScope(t *testing.T) {
+	db, err := gorm.Open(postgres.Open("host=localhost"), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	require.NoError(t, err)
+
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	filters := new(UserFilters).
+		IDGte(2).
+		LoginLike("john").
+		RoleEq("admin").
+		IsActiveEq(true).
+		RatingLt(4.5).
+		AddressIn("10.0.0.0/8").
+		CreatedAfter(created)
+	values := url.Values{"filter": {
+		"id>=2", "login~john", "role:admin", "is_active:true", "rating<4.5", "address<<10.0.0.0/8",
+		"created_after:2024-01-02T03:04:05Z",
+	}}
+
+	var users []User
+	generated := db.Model(&User{}).Scopes(filters.Scope()).Find(&users).Statement
+	require.NoError(t, generated.Error)
+	parsed := db.Model(&User{}).Scopes(filter.FilterByValues(values, filter.FILTER)).Find(&users).Statement
+	require.NoError(t, parsed.Error)
+	require.Equal(t, parsed.SQL.String(), generated.SQL.String())
+	require.Equal(t, parsed.Vars, generated.Vars)
+	require.Contains(t, generated.SQL.String(), `"users"."created_at" >= $7`)
+
+	var categories []Category
+	statement := db.Model(&Category{}).Scopes(new(CategoryFilters).PathDescendantOf("electronics.phones").Scope()).Find(&categories).Statement
+	require.Equal(t, `SELECT * FROM "categories" WHERE "categories"."path" <@ $1::ltree`, statement.SQL.String())
+
+	var bookings []Booking
+	statement = db.Model(&Booking{}).Scopes(new(BookingFilters).DuringOverlaps("2024-05-01..2024-05-07").Scope()).Find(&bookings).Statement
+	require.Equal(t, `SELECT * FROM "bookings" WHERE "bookings"."during" && tstzrange($1, $2)`, statement.SQL.String())
+
+	// The values are taken as is, so they could contain commas.
+	statement = db.Model(&User{}).Scopes(new(UserFilters).LoginEq("doe, john").Scope()).Find(&users).Statement
+	require.Equal(t, `SELECT * FROM "users" WHERE "users"."username" = $1`, statement.SQL.String())
+	require.Equal(t, []interface{}{"doe, john"}, statement.Vars)
+}
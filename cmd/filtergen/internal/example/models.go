@@ -0,0 +1,37 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package example holds the models the filter conditions are generated for in the filtergen tests.
+package example
+
+import "time"
+
+//go:generate go run github.com/ActiveChooN/gin-gorm-filter/cmd/filtergen .
+
+type Role string
+
+type User struct {
+	ID        uint      `filter:"filterable"`
+	Username  string    `filter:"param:login;searchable;filterable"`
+	FullName  string    `filter:"searchable"`
+	Role      Role      `filter:"filterable"`
+	Active    bool      `json:"is_active" filter:"filterable"`
+	Score     *float64  `gorm:"column:rating" filter:"filterable"`
+	Address   string    `gorm:"type:inet" filter:"filterable"`
+	CreatedAt time.Time `filter:"filterable;params:created_after:gte,created_before:lte"`
+	Password  string    `gorm:"-" filter:"filterable"`
+}
+
+type Category struct {
+	ID       uint
+	Path     string `filter:"ltree;filterable"`
+	ParentID *uint
+	Parent   *Category
+}
+
+type Booking struct {
+	ID     uint
+	During string `gorm:"type:tstzrange" filter:"filterable"`
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Command filtergen generates the typed filter conditions of the models from their `filter` tags.
+//
+// For every model with the filterable fields it generates the {Model}Filters builder with the typed setters,
+// e.g. LoginEq(string) or IDGte(uint), and the {Model}Param constants of the filter params. The Scope of the
+// builder delegates to filter.FilterByConditions, so the conditions are resolved the same way as the filter
+// params of the requests. The relation counts and the keys of the JSON fields aren't generated.
+//
+// Usage:
+//
+//	//go:generate go run github.com/ActiveChooN/gin-gorm-filter/cmd/filtergen .
+//
+// or
+//
+//	go run github.com/ActiveChooN/gin-gorm-filter/cmd/filtergen -o filters_gen.go ./models
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	output := flag.String("o", defaultOutput, "name of the generated file in the package directory")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: filtergen [-o file] [dir]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	} else if flag.NArg() == 1 {
+		dir = flag.Arg(0)
+	}
+
+	source, err := generate(dir, *output)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "filtergen:", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(filepath.Join(dir, *output), source, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, "filtergen:", err)
+		os.Exit(1)
+	}
+}
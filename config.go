@@ -0,0 +1,68 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Config defines which query parameters are applied to the query.
+// It's a readable alternative to the SEARCH, FILTER, PAGINATE and ORDER_BY flags,
+// new features get fields here instead of new flags.
+type Config struct {
+	Search   bool // Filter response with LIKE query "search={search_phrase}"
+	Filter   bool // Filter response by column name values "filter={column_name}:{value}"
+	Paginate bool // Paginate response with page and page_size
+	OrderBy  bool // Order response by column name
+	Fields   bool // Select only the selectable columns listed in "fields={column_name},..."
+	Distinct bool // Select distinct rows, e.g. when joins multiply them, counting the distinct primary keys
+	GroupBy  bool // Group the Aggregate buckets by the filterable or sortable column "group_by={column_name}"
+	Deleted  bool // Honor include_deleted and only_deleted for the soft-deleted rows, e.g. for admin screens
+	IDs      bool // Filter by the primary keys listed in "ids={id},...", even if the primary key isn't filterable
+	// FilterRequired rejects the queries without search or filter conditions matching the fields,
+	// e.g. for the endpoints which must never return the whole table. Forced conditions don't count.
+	FilterRequired bool
+}
+
+// ConfigFromBits converts the combination of SEARCH, FILTER, PAGINATE and ORDER_BY flags to the Config.
+func ConfigFromBits(config int) Config {
+	return Config{
+		Search:   config&SEARCH > 0,
+		Filter:   config&FILTER > 0,
+		Paginate: config&PAGINATE > 0,
+		OrderBy:  config&ORDER_BY > 0,
+	}
+}
+
+// Bits converts the Config to the combination of SEARCH, FILTER, PAGINATE and ORDER_BY flags.
+// The conversion is lossy: the fields without the flags, e.g. Fields, Distinct, GroupBy, Deleted, IDs and
+// FilterRequired, are dropped, so they only apply through the entry points taking the Config,
+// such as FilterByQueryConfig and Aggregate, rather than the ones taking the flags.
+func (config Config) Bits() int {
+	var bits int
+	if config.Search {
+		bits |= SEARCH
+	}
+	if config.Filter {
+		bits |= FILTER
+	}
+	if config.Paginate {
+		bits |= PAGINATE
+	}
+	if config.OrderBy {
+		bits |= ORDER_BY
+	}
+	return bits
+}
+
+// FilterByQueryConfig works like FilterByQuery with the Config instead of the flags.
+// Example:
+//
+//	db.Model(&User{}).Scopes(filter.FilterByQueryConfig(c, filter.Config{Filter: true, Paginate: true})).Find(&users)
+func FilterByQueryConfig(c *gin.Context, config Config, opts ...Option) func(db *gorm.DB) *gorm.DB {
+	return filterByQuery(c, config, newOptions(opts), nil)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// TestConfigBits is a test for the conversion between the flags and the Config.
+func (s *TestSuite) TestConfigBits() {
+	tests := []struct {
+		bits   int
+		config Config
+	}{
+		{0, Config{}},
+		{SEARCH, Config{Search: true}},
+		{FILTER, Config{Filter: true}},
+		{SEARCH | FILTER, Config{Search: true, Filter: true}},
+		{PAGINATE, Config{Paginate: true}},
+		{SEARCH | PAGINATE, Config{Search: true, Paginate: true}},
+		{FILTER | PAGINATE, Config{Filter: true, Paginate: true}},
+		{SEARCH | FILTER | PAGINATE, Config{Search: true, Filter: true, Paginate: true}},
+		{ORDER_BY, Config{OrderBy: true}},
+		{SEARCH | ORDER_BY, Config{Search: true, OrderBy: true}},
+		{FILTER | ORDER_BY, Config{Filter: true, OrderBy: true}},
+		{SEARCH | FILTER | ORDER_BY, Config{Search: true, Filter: true, OrderBy: true}},
+		{PAGINATE | ORDER_BY, Config{Paginate: true, OrderBy: true}},
+		{SEARCH | PAGINATE | ORDER_BY, Config{Search: true, Paginate: true, OrderBy: true}},
+		{FILTER | PAGINATE | ORDER_BY, Config{Filter: true, Paginate: true, OrderBy: true}},
+		{ALL, Config{Search: true, Filter: true, Paginate: true, OrderBy: true}},
+	}
+
+	for _, test := range tests {
+		s.Run(fmt.Sprint(test.bits), func() {
+			s.Equal(test.config, ConfigFromBits(test.bits))
+			s.Equal(test.bits, test.config.Bits())
+
+			ctx := gin.Context{}
+			ctx.Request = &http.Request{
+				URL: &url.URL{
+					RawQuery: "filter=login:sampleUser&search=John&page=2&order_by=email",
+				},
+			}
+			dryRun := s.db.Session(&gorm.Session{DryRun: true})
+			var users []User
+			expected := dryRun.Model(&User{}).Scopes(FilterByQuery(&ctx, test.bits)).Find(&users).Statement
+			actual := dryRun.Model(&User{}).Scopes(FilterByQueryConfig(&ctx, test.config)).Find(&users).Statement
+			s.Equal(expected.SQL.String(), actual.SQL.String())
+			s.Equal(expected.Vars, actual.Vars)
+		})
+	}
+}
+
+// TestConfigBitsLossy is a test for the Config fields without the flags dropped by the conversion.
+func (s *TestSuite) TestConfigBitsLossy() {
+	config := Config{
+		Filter: true, OrderBy: true, Fields: true, Distinct: true, GroupBy: true, Deleted: true, IDs: true, FilterRequired: true,
+	}
+	s.Equal(FILTER|ORDER_BY, config.Bits())
+	s.Equal(Config{Filter: true, OrderBy: true}, ConfigFromBits(config.Bits()))
+}
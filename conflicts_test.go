@@ -0,0 +1,55 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestEqualityConflicts is a test for the equality filters on the same column with the different values.
+func (s *TestSuite) TestEqualityConflicts() {
+	tests := []struct {
+		query    string
+		policy   ConflictPolicy
+		expected string
+	}{
+		{"filter=id:1&filter=id:2", ConflictsAnded, `WHERE "users"."id" = \$1 AND "users"."id" = \$2`},
+		{"filter=id:1&filter=id:2&filter=login:bob&filter=id:3", ConflictsIn, `WHERE "users"."id" IN \(\$1,\$2,\$3\) AND "users"."username" = \$4`},
+		// The range bounds aren't the equality conditions.
+		{"filter=id>=1&filter=id<=5", ConflictsRejected, `WHERE "users"."id" >= \$1 AND "users"."id" <= \$2`},
+		{"filter=id:1&filter=id:1", ConflictsRejected, `WHERE "users"."id" = \$1`},
+		{"filter=id:1&filter=id:2&filter_logic=or", ConflictsRejected, `WHERE \("users"."id" = \$1 OR "users"."id" = \$2\)`},
+	}
+	for _, test := range tests {
+		var users []User
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?"+test.query, nil)}
+		s.mock.ExpectQuery(`^SELECT \* FROM "users" ` + test.expected + `$`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+		err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, FILTER, WithEqualityConflicts(test.policy))).Find(&users).Error
+		s.NoError(err, test.query)
+	}
+
+	// The contradictory query isn't sent to the database.
+	var users []User
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?filter=id:1&filter=id:2", nil)}
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, FILTER, WithEqualityConflicts(ConflictsMatchNothing))).Find(&users).Error
+	s.NoError(err)
+	s.Empty(users)
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	rejected, _ := gin.CreateTestContext(httptest.NewRecorder())
+	rejected.Request = httptest.NewRequest(http.MethodGet, "/users?filter=id:1&filter=id:2", nil)
+	err = s.db.Model(&User{}).Scopes(FilterByQuery(rejected, FILTER, WithEqualityConflicts(ConflictsRejected))).Find(&users).Error
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal(&Error{Param: "filter", Value: "id:2", Reason: "contradicts id:1, use filter_logic=or to match any of the values"}, filterErr)
+	s.Equal(http.StatusBadRequest, rejected.Writer.Status())
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"context"
+	"net/http"
+
+	"gorm.io/gorm"
+)
+
+type contextKey struct{}
+
+// contextQuery is the parsed query stored in the context by the Middleware.
+type contextQuery struct {
+	query  Query
+	config Config
+	opts   []Option
+}
+
+// Middleware parses the query parameters of the request and stores the query in the request context,
+// so it could be applied with ScopeFromContext without the request, e.g. in the repository layer.
+// Malformed query parameters are responded with the 400 status code.
+// Example:
+//
+//	http.Handle("/users", filter.Middleware(filter.ALL)(usersHandler))
+//
+//	// in the repository
+//	err := db.WithContext(ctx).Model(&User{}).Scopes(filter.ScopeFromContext(ctx)).Find(&users).Error
+func Middleware(config int, opts ...Option) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cfg, o := ConfigFromBits(config), newOptions(opts)
+			query, err := parseQuery(requestValues(r, o), cfg, o)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			ctx := context.WithValue(r.Context(), contextKey{}, contextQuery{query: query, config: cfg, opts: opts})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// FromContext returns the query stored in the context by the Middleware.
+// The filter conditions are resolved against the model when the scope is applied,
+// so Filters of the returned query are empty.
+func FromContext(ctx context.Context) (Query, bool) {
+	stored, ok := ctx.Value(contextKey{}).(contextQuery)
+	return stored.query, ok
+}
+
+// ScopeFromContext filters DB request with the query stored in the context by the Middleware.
+// The options are applied after the Middleware ones. The scope does nothing if there is no query in the context.
+func ScopeFromContext(ctx context.Context, opts ...Option) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		stored, ok := ctx.Value(contextKey{}).(contextQuery)
+		if !ok {
+			return db
+		}
+		o := newOptions(append(append([]Option(nil), stored.opts...), opts...))
+		db, err := applyQuery(db, nil, stored.query, stored.config, o, nil)
+		if err != nil {
+			_ = addError(db, nil, o, err)
+		}
+		return db
+	}
+}
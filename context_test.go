@@ -0,0 +1,83 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// findUsers applies the query from the context without the request, like the repository layer does.
+func (s *TestSuite) findUsers(ctx context.Context) ([]User, error) {
+	var users []User
+	err := s.db.WithContext(ctx).Model(&User{}).Scopes(ScopeFromContext(ctx)).Find(&users).Error
+	return users, err
+}
+
+// TestMiddleware is a test for applying the query stored in the context by the middleware.
+func (s *TestSuite) TestMiddleware() {
+	var query Query
+	handler := Middleware(ALL)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ok bool
+		query, ok = FromContext(r.Context())
+		s.True(ok)
+		_, err := s.findUsers(r.Context())
+		s.NoError(err)
+	}))
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) AND "users"."username" = \$3 ORDER BY "email" DESC LIMIT \$4 OFFSET \$5$`).
+		WithArgs("%john%", "%john%", "sampleUser", 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/users?filter=login:sampleUser&search=John&page=2&order_by=email", nil))
+	s.Equal(http.StatusOK, recorder.Code)
+	s.Equal("John", query.Search)
+	s.Equal(2, query.Page)
+	s.Equal("email", query.OrderBy)
+}
+
+// TestMiddlewareFilterHeader is a test for the filter phrases of the header parsed by the middleware.
+func (s *TestSuite) TestMiddlewareFilterHeader() {
+	handler := Middleware(FILTER, WithFilterHeader("X-Filter"))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := s.findUsers(r.Context())
+		s.NoError(err)
+	}))
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1$`).
+		WithArgs("sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	r := httptest.NewRequest(http.MethodGet, "/users", nil)
+	r.Header.Set("X-Filter", "login:sampleUser")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, r)
+	s.Equal(http.StatusOK, recorder.Code)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+// TestMiddlewareError is a test for responding to malformed query parameters in the middleware.
+func (s *TestSuite) TestMiddlewareError() {
+	handler := Middleware(ALL)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Fail("handler should not be called")
+	}))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/users?page=first", nil))
+	s.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+// TestScopeFromContextEmpty is a test for the scope without the query in the context.
+func (s *TestSuite) TestScopeFromContextEmpty() {
+	_, ok := FromContext(context.Background())
+	s.False(ok)
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	_, err := s.findUsers(context.Background())
+	s.NoError(err)
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Count counts the models matching the search and the filters of the request with a single COUNT query,
+// without selecting them, e.g. for the HEAD requests. Only the SEARCH and FILTER flags of the config are applied.
+// Example:
+//
+//	router.HEAD("/users", func(c *gin.Context) {
+//		total, err := filter.Count(c, db, &User{}, filter.ALL)
+//		...
+//		c.Header("X-Total-Count", strconv.FormatInt(total, 10))
+//	})
+func Count(c *gin.Context, db *gorm.DB, model interface{}, config int, opts ...Option) (int64, error) {
+	var total int64
+	err := db.Model(model).Scopes(FilterByQuery(c, config&(SEARCH|FILTER), opts...)).Count(&total).Error
+	return total, err
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestCount is a test for counting the filtered models with a single COUNT query.
+func (s *TestSuite) TestCount() {
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodHead, "/users?search=John&filter=login:sampleUser&page=2&order_by=email", nil)}
+
+	s.mock.ExpectQuery(`^SELECT count\(\*\) FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) `+
+		`AND "users"."username" = \$3$`).
+		WithArgs("%john%", "%john%", "sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1234))
+	total, err := Count(&ctx, s.db, &User{}, ALL)
+	s.NoError(err)
+	s.Equal(int64(1234), total)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
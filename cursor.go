@@ -0,0 +1,94 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// TimeCursor is the cursor of the feed-style pagination by the time column, see WithTimeCursor.
+type TimeCursor struct {
+	// Time is the time of the last row seen.
+	Time time.Time `json:"time"`
+	// ID is the primary key of the last row seen, if the client sent it. It breaks the ties of the rows
+	// with the equal times, so they aren't skipped.
+	ID string `json:"id,omitempty"`
+	// Before pages back to the earlier rows, newest first, instead of the later ones.
+	Before bool `json:"before,omitempty"`
+}
+
+// parseTimeCursor parses the after or before param of the time cursor, nil if neither is present.
+// The cursor is the date or the RFC 3339 time, optionally followed by the primary key after a comma,
+// e.g. after=2024-05-01T12:00:00Z,42.
+func parseTimeCursor(values url.Values, o *options) (*TimeCursor, error) {
+	after, hasAfter := lookupParam(values, "after")
+	before, hasBefore := lookupParam(values, "before")
+	switch {
+	case !hasAfter && !hasBefore:
+		return nil, nil
+	case hasAfter && hasBefore:
+		return nil, &Error{Param: "before", Value: before, Reason: "can't be combined with after"}
+	case values.Has("page"):
+		return nil, &Error{Param: "page", Value: values.Get("page"), Reason: "can't be combined with the time cursor"}
+	}
+
+	param, value := "after", after
+	if hasBefore {
+		param, value = "before", before
+	}
+	timeValue, id, _ := strings.Cut(value, ",")
+	t, ok := parseTimeValue(timeValue, o.location)
+	if !ok {
+		return nil, &Error{Param: param, Value: value, Reason: "must be a date or an RFC 3339 time"}
+	}
+	return &TimeCursor{Time: t, ID: id, Before: hasBefore}, nil
+}
+
+// parseTimeValue parses the RFC 3339 time or the date, at the midnight in the location.
+func parseTimeValue(value string, location *time.Location) (time.Time, bool) {
+	for _, layout := range rangeTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, location); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// cursorExpression builds the condition of the rows after or before the cursor. The rows with the time of
+// the cursor are compared by the primary key if the cursor has it.
+func cursorExpression(table string, meta *modelMeta, cursor *TimeCursor, o *options) clause.Expression {
+	column := clause.Column{Table: table, Name: o.timeCursor}
+	var after clause.Expression = clause.Gt{Column: column, Value: cursor.Time}
+	if cursor.Before {
+		after = clause.Lt{Column: column, Value: cursor.Time}
+	}
+	if cursor.ID == "" || meta == nil || len(meta.primaryKeys) != 1 {
+		return after
+	}
+
+	primaryKey := clause.Column{Table: table, Name: meta.primaryKeys[0]}
+	var tie clause.Expression = clause.Gt{Column: primaryKey, Value: cursor.ID}
+	if cursor.Before {
+		tie = clause.Lt{Column: primaryKey, Value: cursor.ID}
+	}
+	return clause.Or(after, clause.And(clause.Eq{Column: column, Value: cursor.Time}, tie))
+}
+
+// cursorOrderColumns returns the columns the rows of the cursor are ordered by: the time column, oldest first
+// after it and newest first before it, then the primary key as the tiebreaker.
+func cursorOrderColumns(meta *modelMeta, cursor *TimeCursor, o *options) []clause.OrderByColumn {
+	columns := []clause.OrderByColumn{{Column: clause.Column{Name: o.timeCursor}, Desc: cursor.Before}}
+	if meta != nil {
+		for _, primaryKey := range meta.primaryKeys {
+			columns = append(columns, clause.OrderByColumn{Column: clause.Column{Name: primaryKey}, Desc: cursor.Before})
+		}
+	}
+	return columns
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"database/sql/driver"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+type Activity struct {
+	Id        uint
+	Action    string `filter:"filterable"`
+	CreatedAt time.Time
+}
+
+// TestTimeCursor is a test for paging the rows by the time cursor.
+func (s *TestSuite) TestTimeCursor() {
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		query    string
+		expected string
+		args     []driver.Value
+	}{
+		{"after=2024-05-01T12:00:00Z&page_size=50",
+			`WHERE "activities"."created_at" > \$1 ORDER BY "created_at","id" LIMIT \$2`,
+			[]driver.Value{at, 50}},
+		{"before=2024-05-01T12:00:00Z&page_size=50",
+			`WHERE "activities"."created_at" < \$1 ORDER BY "created_at" DESC,"id" DESC LIMIT \$2`,
+			[]driver.Value{at, 50}},
+		{"after=2024-05-01T12:00:00Z,42&filter=action:login",
+			`WHERE "activities"."action" = \$1 AND \("activities"."created_at" > \$2 OR \("activities"."created_at" = \$3 AND "activities"."id" > \$4\)\) ` +
+				`ORDER BY "created_at","id" LIMIT \$5`,
+			[]driver.Value{"login", at, at, "42", 10}},
+		{"after=2024-05-01&order_by=action",
+			`WHERE "activities"."created_at" > \$1 ORDER BY "created_at","id" LIMIT \$2`,
+			[]driver.Value{time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), 10}},
+	}
+	for _, test := range tests {
+		var activities []Activity
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/activities?"+test.query, nil)}
+		s.mock.ExpectQuery(`^SELECT \* FROM "activities" ` + test.expected + `$`).
+			WithArgs(test.args...).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "action", "created_at"}))
+		err := s.db.Model(&Activity{}).Scopes(FilterByQuery(&ctx, ALL, WithTimeCursor("created_at"))).Find(&activities).Error
+		s.NoError(err, test.query)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	// The cursor replaces the page and is parsed only with the option.
+	invalid := map[string]*Error{
+		"after=yesterday":                    {Param: "after", Value: "yesterday", Reason: "must be a date or an RFC 3339 time"},
+		"after=2024-05-01&before=2024-06-01": {Param: "before", Value: "2024-06-01", Reason: "can't be combined with after"},
+		"after=2024-05-01&page=2":            {Param: "page", Value: "2", Reason: "can't be combined with the time cursor"},
+	}
+	for query, expected := range invalid {
+		values := httptest.NewRequest(http.MethodGet, "/activities?"+query, nil).URL.Query()
+		_, err := ParseQuery(values, &Activity{}, PAGINATE, WithTimeCursor("created_at"))
+		s.Equal(expected, err, query)
+	}
+	values := httptest.NewRequest(http.MethodGet, "/activities?after=yesterday", nil).URL.Query()
+	query, err := ParseQuery(values, &Activity{}, PAGINATE)
+	s.NoError(err)
+	s.Nil(query.Cursor)
+}
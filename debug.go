@@ -0,0 +1,60 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import "slices"
+
+// noModel reports the search and the filters skipped without the model, rejecting them in the strict mode.
+func (o *options) noModel(query Query) error {
+	if o.strict {
+		return ErrNoModel
+	}
+	if o.debugLogger != nil {
+		o.debugLogger("filter skipped without the model", map[string]interface{}{
+			"search":  query.Search,
+			"filter":  query.filter,
+			"presets": query.Presets,
+		})
+	}
+	return nil
+}
+
+// logConditions logs the applied conditions of the filter phrase and the skipped terms with the reasons.
+func logConditions(logger func(msg string, fields map[string]interface{}), phrase string, terms []filterTerm, applied []Condition, meta *modelMeta) {
+	for _, condition := range applied {
+		logger("filter condition applied", map[string]interface{}{
+			"phrase":   phrase,
+			"param":    condition.Param,
+			"column":   condition.Column,
+			"operator": condition.Operator,
+			"value":    condition.Value,
+		})
+	}
+
+	for i, term := range terms {
+		var reason string
+		index := slices.IndexFunc(meta.fields, func(field fieldMeta) bool { return field.param == term.param })
+		switch {
+		case index < 0:
+			reason = "unknown param"
+		case !meta.fields[index].Filterable:
+			reason = "field is not filterable"
+		case meta.fields[index].Operator != "" && term.operator != ":":
+			reason = "operator is bound"
+		case slices.ContainsFunc(terms[:i], func(previous filterTerm) bool { return previous.param == term.param }):
+			reason = "duplicate param"
+		default:
+			continue
+		}
+		logger("filter condition skipped", map[string]interface{}{
+			"phrase":   phrase,
+			"param":    term.param,
+			"operator": term.operator,
+			"value":    term.value,
+			"reason":   reason,
+		})
+	}
+}
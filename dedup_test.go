@@ -0,0 +1,42 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestDeduplicateFilters is a test for dropping the exactly identical filter conditions.
+func (s *TestSuite) TestDeduplicateFilters() {
+	tests := []struct {
+		query        string
+		opts         []Option
+		expected     string
+		applied      int
+		deduplicated int
+	}{
+		{"filter=login:bob&filter=login:bob", nil, `WHERE "users"."username" = \$1`, 1, 1},
+		{"filter=login:bob&filter=login:Bob", nil, `WHERE "users"."username" = \$1 AND "users"."username" = \$2`, 2, 0},
+		{"filter=login:bob,email:bob@example.com&filter=login:bob", []Option{WithFilterCombinator(Or)},
+			`WHERE \(\("users"."username" = \$1 AND "users"."email" = \$2\) OR "users"."username" = \$3\)`, 3, 0},
+	}
+	for _, test := range tests {
+		var users []User
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?"+test.query, nil)}
+		s.mock.ExpectQuery(`^SELECT \* FROM "users" ` + test.expected + `$`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+		scope, meta := ParseAndScope(&ctx, FILTER, test.opts...)
+		err := s.db.Model(&User{}).Scopes(scope).Find(&users).Error
+		s.NoError(err, test.query)
+		s.Equal(test.deduplicated, meta.DeduplicatedFilters, test.query)
+		s.Len(meta.AppliedFilters, test.applied, test.query)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+type Listing struct {
+	Id     uint
+	Title  string `filter:"filterable"`
+	Status string `filter:"param:status;filterable;default:active"`
+}
+
+// TestFilterDefault is a test for the default filter values of the fields.
+func (s *TestSuite) TestFilterDefault() {
+	tests := []struct {
+		query    string
+		config   int
+		expected string
+	}{
+		{"", FILTER, `WHERE "listings"."status" = \$1`},
+		{"filter=title:Flat", FILTER, `WHERE "listings"."title" = \$1 AND "listings"."status" = \$2`},
+		{"filter=status:archived", FILTER, `WHERE "listings"."status" = \$1`},
+		{"filter=status!=active", FILTER, `WHERE "listings"."status" <> \$1`},
+		{"filter=status:*", FILTER, ``},
+		{"filter=status:*,title:Flat", FILTER, `WHERE "listings"."title" = \$1`},
+		{"", SEARCH | ORDER_BY, `ORDER BY "id" DESC`},
+	}
+	for _, test := range tests {
+		var listings []Listing
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/listings?"+test.query, nil)}
+		expected := `^SELECT \* FROM "listings"$`
+		if test.expected != "" {
+			expected = `^SELECT \* FROM "listings" ` + test.expected + `$`
+		}
+		s.mock.ExpectQuery(expected).WillReturnRows(sqlmock.NewRows([]string{"id", "title", "status"}))
+		err := s.db.Model(&Listing{}).Scopes(FilterByQuery(&ctx, test.config)).Find(&listings).Error
+		s.NoError(err, test.query)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	// The client filter replaces the default value.
+	query, err := ParseQuery(map[string][]string{"filter": {"status:archived"}}, &Listing{}, FILTER)
+	s.NoError(err)
+	s.Equal([]Condition{{Field: "Status", Param: "status", Column: "status", Operator: ":", Value: "archived"}}, query.Filters)
+
+	// The opt-out matches the field in the strict mode.
+	_, err = ParseQuery(map[string][]string{"filter": {"status:*"}}, &Listing{}, FILTER, WithStrict())
+	s.NoError(err)
+
+	// The empty default is malformed.
+	field, _, reasons := parseTag("Status", "filterable;default:")
+	s.Equal(Field{Name: "Status", Filterable: true}, field)
+	s.Equal([]string{"empty default"}, reasons)
+}
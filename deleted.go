@@ -0,0 +1,23 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// deletedExpression builds the expression selecting only the soft-deleted rows if only_deleted is set,
+// nil otherwise or if the model isn't soft-deleted.
+func deletedExpression(db *gorm.DB, query Query, o *options) clause.Expression {
+	if !query.OnlyDeleted {
+		return nil
+	}
+	if _, meta, ok := queryMeta(db, o); ok && meta.deletedAt != "" {
+		return clause.Neq{Column: clause.Column{Table: clause.CurrentTable, Name: meta.deletedAt}, Value: nil}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type Customer struct {
+	Id        uint   `filter:"param:id;filterable"`
+	Name      string `filter:"param:name;filterable"`
+	DeletedAt gorm.DeletedAt
+}
+
+// TestDeleted is a test for including the soft-deleted rows.
+func (s *TestSuite) TestDeleted() {
+	tests := []struct {
+		query    string
+		config   Config
+		expected string
+	}{
+		{"include_deleted=true", Config{Filter: true, Deleted: true}, `SELECT * FROM "customers"`},
+		{"include_deleted=true&filter=name:bob", Config{Filter: true, Deleted: true}, `SELECT * FROM "customers" WHERE "customers"."name" = $1`},
+		{"only_deleted=1&filter=name:bob", Config{Filter: true, Deleted: true},
+			`SELECT * FROM "customers" WHERE "customers"."name" = $1 AND "customers"."deleted_at" IS NOT NULL`},
+		{"include_deleted=false", Config{Filter: true, Deleted: true}, `SELECT * FROM "customers" WHERE "customers"."deleted_at" IS NULL`},
+		{"include_deleted=true", Config{Filter: true}, `SELECT * FROM "customers" WHERE "customers"."deleted_at" IS NULL`},
+		{"only_deleted=true", Config{Filter: true}, `SELECT * FROM "customers" WHERE "customers"."deleted_at" IS NULL`},
+	}
+
+	dryRun := s.db.Session(&gorm.Session{DryRun: true})
+	for _, test := range tests {
+		s.Run(test.query, func() {
+			var customers []Customer
+			ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/customers?"+test.query, nil)}
+			statement := dryRun.Model(&Customer{}).Scopes(FilterByQueryConfig(&ctx, test.config)).Find(&customers).Statement
+			s.Equal(test.expected, statement.SQL.String())
+		})
+	}
+}
+
+// TestDeletedMalformed is a test for the malformed include_deleted param, only rejected with the opt-in.
+func (s *TestSuite) TestDeletedMalformed() {
+	var customers []Customer
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/customers?include_deleted=maybe", nil)
+	err := s.db.Session(&gorm.Session{DryRun: true}).Model(&Customer{}).
+		Scopes(FilterByQueryConfig(ctx, Config{Filter: true})).Find(&customers).Error
+	s.NoError(err)
+
+	err = s.db.Session(&gorm.Session{DryRun: true}).Model(&Customer{}).
+		Scopes(FilterByQueryConfig(ctx, Config{Deleted: true})).Find(&customers).Error
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal("include_deleted", filterErr.Param)
+}
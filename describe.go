@@ -0,0 +1,123 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"reflect"
+	"slices"
+	"time"
+)
+
+// FieldType is the type of the field values.
+type FieldType string
+
+const (
+	TypeString FieldType = "string"
+	TypeInt    FieldType = "int"
+	TypeFloat  FieldType = "float"
+	TypeBool   FieldType = "bool"
+	TypeTime   FieldType = "time"
+	TypeUUID   FieldType = "uuid"
+	TypeINet   FieldType = "inet"
+)
+
+// ModelDescription describes how the model could be filtered, e.g. to build the filter UI dynamically.
+type ModelDescription struct {
+	Fields []FieldDescription `json:"fields"`
+}
+
+// FieldDescription describes the capabilities of the model field.
+type FieldDescription struct {
+	Param      string    `json:"param"`
+	Type       FieldType `json:"type,omitempty"`
+	Filterable bool      `json:"filterable,omitempty"`
+	Searchable bool      `json:"searchable,omitempty"`
+	Sortable   bool      `json:"sortable,omitempty"`
+	Selectable bool      `json:"selectable,omitempty"`
+	// Operators are the filter operators of the filterable field.
+	Operators []string `json:"operators,omitempty"`
+}
+
+// Describe describes the fields of the model which could be filtered, searched, ordered or selected.
+// The description comes from the same metadata the scopes use, with the default naming strategy.
+// Example:
+//
+//	c.JSON(http.StatusOK, filter.Describe(&User{}))
+func Describe(model interface{}) ModelDescription {
+	description := ModelDescription{Fields: []FieldDescription{}}
+	_, meta, ok := defaultQueryMeta(model, newOptions(nil))
+	if !ok {
+		return description
+	}
+	for _, field := range meta.fields {
+		if !field.Filterable && !field.Searchable && !field.Sortable && !field.Selectable {
+			continue
+		}
+		fieldDescription := FieldDescription{
+			Param:      field.param,
+			Type:       field.valueType,
+			Filterable: field.Filterable,
+			Searchable: field.Searchable,
+			Sortable:   field.Sortable,
+			Selectable: field.Selectable,
+		}
+		if field.Filterable {
+			fieldDescription.Operators = fieldOperators(field)
+		}
+		description.Fields = append(description.Fields, fieldDescription)
+	}
+	return description
+}
+
+// fieldOperators returns the filter operators of the filterable field.
+func fieldOperators(field fieldMeta) []string {
+	switch {
+	case field.count:
+		return slices.Clone(comparisonOperators)
+	case field.Operator != "":
+		return []string{":"}
+	case field.json:
+		return slices.Clone(jsonOperators)
+	case field.INet:
+		return slices.Clone(inetOperators)
+	case field.LTree:
+		return slices.Clone(ltreeOperators)
+	case field.Range:
+		return slices.Clone(rangeOperators)
+	case field.valueType == TypeTime:
+		return slices.Concat([]string{":", "!=", ">", ">=", "<", "<=", "~"}, granularityOperators, customOperators())
+	case field.Fuzzy:
+		return slices.Concat([]string{":", "!=", ">", ">=", "<", "<=", "~", fuzzyOperator}, customOperators())
+	default:
+		return append([]string{":", "!=", ">", ">=", "<", "<=", "~"}, customOperators()...)
+	}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// valueType returns the type of the field values, the string one for the unknown types.
+func valueType(fieldType reflect.Type) FieldType {
+	if fieldType == nil {
+		return ""
+	}
+	if fieldType == timeType || fieldType == deletedAtType {
+		return TypeTime
+	}
+	if fieldType.Name() == "UUID" {
+		return TypeUUID
+	}
+	switch fieldType.Kind() {
+	case reflect.Bool:
+		return TypeBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return TypeInt
+	case reflect.Float32, reflect.Float64:
+		return TypeFloat
+	default:
+		return TypeString
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// TestDescribe is a test for the JSON description of the models.
+func (s *TestSuite) TestDescribe() {
+	operators := `"operators":[":","!=",">",">=","<","<=","~"]`
+	users := `{"fields":[` +
+		`{"param":"id","type":"int","filterable":true,` + operators + `},` +
+		`{"param":"login","type":"string","filterable":true,"searchable":true,` + operators + `},` +
+		`{"param":"name","type":"string","searchable":true},` +
+		`{"param":"email","type":"string","filterable":true,` + operators + `}]}`
+	tests := []struct {
+		model    interface{}
+		expected string
+	}{
+		{&User{}, users},
+		{&Organization{}, `{"fields":[` +
+			`{"param":"id","type":"int","filterable":true,` + operators + `},` +
+			`{"param":"name","type":"string","searchable":true},` +
+			`{"param":"users.count","type":"int","filterable":true,"operators":[":","!=",">",">=","<","<="]}]}`},
+		{User{}, users},
+		{&[]User{}, users},
+		{"users", `{"fields":[]}`},
+	}
+
+	for _, test := range tests {
+		data, err := json.Marshal(Describe(test.model))
+		s.Require().NoError(err)
+		s.JSONEq(test.expected, string(data))
+
+		var description ModelDescription
+		s.Require().NoError(json.Unmarshal(data, &description))
+		s.Equal(Describe(test.model), description)
+	}
+}
+
+// TestDescribeTypes is a test for the types of the field values.
+func (s *TestSuite) TestDescribeTypes() {
+	type UUID [16]byte
+	type Event struct {
+		Id        UUID      `filter:"param:id;filterable"`
+		Public    *bool     `filter:"param:public;filterable"`
+		Score     float64   `filter:"param:score;sortable"`
+		CreatedAt time.Time `filter:"param:created_at;sortable;selectable"`
+	}
+
+	description := Describe(&Event{})
+	s.Require().Len(description.Fields, 4)
+	s.Equal(TypeUUID, description.Fields[0].Type)
+	s.Equal(TypeBool, description.Fields[1].Type)
+	s.Equal(TypeFloat, description.Fields[2].Type)
+	s.Nil(description.Fields[2].Operators)
+	s.Equal(TypeTime, description.Fields[3].Type)
+	s.True(description.Fields[3].Selectable)
+}
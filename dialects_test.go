@@ -0,0 +1,153 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/sqlserver"
+	"gorm.io/gorm"
+)
+
+type MySQLSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	mock sqlmock.Sqlmock
+}
+
+func (s *MySQLSuite) SetupTest() {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, s.mock, err = sqlmock.New()
+	s.NoError(err)
+
+	dialector := mysql.New(mysql.Config{
+		DSN:                       "sqlmock_db_0",
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	})
+
+	s.db, err = gorm.Open(dialector, &gorm.Config{})
+	require.NoError(s.T(), err)
+}
+
+func (s *MySQLSuite) TearDownTest() {
+	db, err := s.db.DB()
+	require.NoError(s.T(), err)
+	db.Close()
+}
+
+// TestFuzzyRejected is a test for rejecting the fuzzy filters without levenshtein().
+func (s *MySQLSuite) TestFuzzyRejected() {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/patients?filter=last_name~?smth", nil)
+	err := s.db.Model(&Patient{}).Scopes(FilterByQuery(ctx, FILTER)).Find(&[]Patient{}).Error
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal(&Error{Param: "filter", Value: "last_name~?smth", Reason: "fuzzy matching is only supported by postgres"}, filterErr)
+	s.Equal(http.StatusBadRequest, ctx.Writer.Status())
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+// TestIDsOrder is a test for keeping the order of the listed ids with CASE.
+func (s *MySQLSuite) TestIDsOrder() {
+	var activities []Activity
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/activities?ids=7,3,9&order_by=ids", nil)}
+	s.mock.ExpectQuery("^SELECT \\* FROM `activities` WHERE `activities`.`id` IN \\(\\?,\\?,\\?\\) "+
+		"ORDER BY CASE `activities`.`id` WHEN \\? THEN 0 WHEN \\? THEN 1 WHEN \\? THEN 2 END$").
+		WithArgs(uint64(7), uint64(3), uint64(9), uint64(7), uint64(3), uint64(9)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "action", "created_at"}))
+	config := Config{Filter: true, OrderBy: true, IDs: true}
+	err := s.db.Model(&Activity{}).Scopes(FilterByQueryConfig(&ctx, config)).Find(&activities).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+// TestSearch is a test for the plain LIKE search relying on the case-insensitive collations.
+func (s *MySQLSuite) TestSearch() {
+	var users []User
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?search=John_&filter=login:sampleUser", nil)}
+
+	s.mock.ExpectQuery("^SELECT \\* FROM `users` WHERE \\(`users`.`username` LIKE \\? ESCAPE '\\\\\\\\' OR `users`.`full_name` LIKE \\? ESCAPE '\\\\\\\\'\\) "+
+		"AND `users`.`username` = \\?$").
+		WithArgs(`%john\_%`, `%john\_%`, "sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, SEARCH|FILTER)).Find(&users).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+// TestSearchCaseTags is a test for lowering the fields tagged as case-insensitive.
+func (s *MySQLSuite) TestSearchCaseTags() {
+	var articles []Article
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/articles?search=John", nil)}
+
+	s.mock.ExpectQuery("^SELECT \\* FROM `articles` WHERE \\(LOWER\\(`articles`.`title`\\) LIKE \\? OR `articles`.`body` LIKE \\? OR `articles`.`code` LIKE \\?\\)$").
+		WithArgs("%john%", "%john%", "%John%").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "body", "code"}))
+	err := s.db.Model(&Article{}).Scopes(FilterByQuery(&ctx, SEARCH)).Find(&articles).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func TestRunMySQLSuite(t *testing.T) {
+	suite.Run(t, new(MySQLSuite))
+}
+
+// TestSQLServerPagination is a test for ordering the pages by the primary key, since SQL Server
+// only pages the ordered rows.
+func (s *TestSuite) TestSQLServerPagination() {
+	db, err := gorm.Open(sqlserver.Open("sqlserver://sqlmock_db_0"), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	s.Require().NoError(err)
+
+	tests := []struct {
+		query    string
+		config   int
+		expected string
+	}{
+		{"page=3&page_size=20", PAGINATE,
+			`SELECT * FROM "users" ORDER BY "users"."id" OFFSET 40 ROWS FETCH NEXT 20 ROWS ONLY`},
+		{"page_size=20", PAGINATE,
+			`SELECT * FROM "users" ORDER BY "users"."id" OFFSET 0 ROW FETCH NEXT 20 ROWS ONLY`},
+		{"page=3&page_size=20&order_by=email", PAGINATE | ORDER_BY,
+			`SELECT * FROM "users" ORDER BY "email" DESC OFFSET 40 ROWS FETCH NEXT 20 ROWS ONLY`},
+		{"page=3&all=true", PAGINATE, `SELECT * FROM "users"`},
+	}
+
+	for _, test := range tests {
+		s.Run(test.query, func() {
+			var users []User
+			ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?"+test.query, nil)}
+			statement := db.Model(&User{}).Scopes(FilterByQuery(&ctx, test.config)).Find(&users).Statement
+			s.Equal(test.expected, statement.SQL.String())
+		})
+	}
+}
+
+// TestInsensitiveLike is a test for lowering the like filters of the fields tagged as case-insensitive.
+func (s *MySQLSuite) TestInsensitiveLike() {
+	var notes []Note
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/notes?filter=tag~Urgent,title~Draft", nil)}
+
+	s.mock.ExpectQuery("^SELECT \\* FROM `notes` WHERE `notes`.`title` LIKE \\? AND LOWER\\(`notes`.`tag`\\) LIKE \\?$").
+		WithArgs("draft", "urgent").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "code", "tag"}))
+	err := s.db.Model(&Note{}).Scopes(FilterByQuery(&ctx, FILTER, WithInsensitiveLike())).Find(&notes).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type Audit struct {
+	CreatedBy string `filter:"filterable;searchable"`
+	Comment   string `filter:"searchable"`
+}
+
+type Ledger struct {
+	gorm.Model
+	Audit
+	Title string `filter:"filterable"`
+}
+
+type Receipt struct {
+	Id    uint
+	Audit Audit `gorm:"embedded;embeddedPrefix:audit_"`
+}
+
+// TestFilterEmbedded is a test for filtering and searching the fields of the embedded structs.
+func (s *TestSuite) TestFilterEmbedded() {
+	var ledgers []Ledger
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/ledgers?search=Bob&filter=created_by:bob,title:Q1", nil)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "ledgers" WHERE \(\("ledgers"."created_by" ILIKE \$1 OR "ledgers"."comment" ILIKE \$2\) `+
+		`AND \("ledgers"."created_by" = \$3 AND "ledgers"."title" = \$4\)\) AND "ledgers"."deleted_at" IS NULL$`).
+		WithArgs("%bob%", "%bob%", "bob", "Q1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_by", "comment", "title"}))
+	err := s.db.Model(&Ledger{}).Scopes(FilterByQuery(&ctx, SEARCH|FILTER)).Find(&ledgers).Error
+	s.NoError(err)
+
+	// The columns of the prefixed structs are resolved through the schema, so the params are prefixed too.
+	var receipts []Receipt
+	ctx = gin.Context{Request: httptest.NewRequest(http.MethodGet, "/receipts?search=Bob&filter=audit_created_by:bob", nil)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "receipts" WHERE \("receipts"."audit_created_by" ILIKE \$1 OR "receipts"."audit_comment" ILIKE \$2\) `+
+		`AND "receipts"."audit_created_by" = \$3$`).
+		WithArgs("%bob%", "%bob%", "bob").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "audit_created_by", "audit_comment"}))
+	err = s.db.Model(&Receipt{}).Scopes(FilterByQuery(&ctx, SEARCH|FILTER)).Find(&receipts).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+	s.NoError(Validate(&Ledger{}, &Receipt{}))
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNoModel is the error of the search and the filters of the statement without the model in the strict mode,
+// e.g. if Model was forgotten or the query only sets Table.
+var ErrNoModel = errors.New("filter: the statement has no model to search or filter, set it with Model or use FilterByQueryWithModel")
+
+// Error describes a malformed query parameter, or one rejected in the strict mode.
+type Error struct {
+	Param  string // Query parameter name, e.g. "page" or "filter"
+	Value  string // Query parameter value
+	Reason string
+	Status int // HTTP status code the request is aborted with, 400 if it's zero
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("filter: invalid %s %q: %s", e.Param, e.Value, e.Reason)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TestMalformedParamError is a test for describing the malformed query parameters with the Error.
+func (s *TestSuite) TestMalformedParamError() {
+	_, err := ParseQuery(url.Values{"page": {"first"}}, &User{}, ALL)
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal(&Error{Param: "page", Value: "first", Reason: "must be an integer"}, filterErr)
+	s.Equal(`filter: invalid page "first": must be an integer`, err.Error())
+}
+
+// TestStrict is a test for rejecting the ignored query parameters in the strict mode.
+func (s *TestSuite) TestStrict() {
+	var users []User
+	r := httptest.NewRequest(http.MethodGet, "/users?filter=password:secret", nil)
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByRequest(r, FILTER)).Find(&users).Error
+	s.NoError(err)
+
+	err = s.db.Model(&User{}).Scopes(FilterByRequest(r, FILTER, WithStrict())).Find(&users).Error
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal("filter", filterErr.Param)
+	s.Equal("password:secret", filterErr.Value)
+
+	_, err = ParseQuery(url.Values{"order_direction": {"up"}}, &User{}, ALL, WithStrict())
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal("order_direction", filterErr.Param)
+}
+
+// TestFilterRequired is a test for rejecting the queries without search or filter conditions.
+func (s *TestSuite) TestFilterRequired() {
+	config := Config{Search: true, Filter: true, FilterRequired: true}
+	forced := WithForcedConditions(func(c *gin.Context) []clause.Expression {
+		return []clause.Expression{clause.Eq{Column: "organization_id", Value: 1}}
+	})
+	tests := []struct {
+		query    string
+		rejected bool
+	}{
+		{"filter=login:sampleUser", false},
+		{"search=John", false},
+		{"", true},
+		{"filter=password:secret", true},
+	}
+
+	for _, test := range tests {
+		s.Run(test.query, func() {
+			var users []User
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/users?"+test.query, nil)
+			err := s.db.Session(&gorm.Session{DryRun: true}).Model(&User{}).
+				Scopes(FilterByQueryConfig(ctx, config, forced)).Find(&users).Error
+			if !test.rejected {
+				s.NoError(err)
+				return
+			}
+			var filterErr *Error
+			s.Require().True(errors.As(err, &filterErr))
+			s.Equal(&Error{Param: "filter", Reason: "search or filter is required"}, filterErr)
+			s.Equal(http.StatusBadRequest, ctx.Writer.Status())
+		})
+	}
+}
+
+// TestErrorHandler is a test for transforming the errors with the handler.
+func (s *TestSuite) TestErrorHandler() {
+	var users []User
+	r := httptest.NewRequest(http.MethodGet, "/users?page=first", nil)
+	errBadRequest := errors.New("bad request")
+
+	err := s.db.Model(&User{}).Scopes(FilterByRequest(r, ALL, WithErrorHandler(func(err error) error {
+		return errBadRequest
+	}))).Find(&users).Error
+	s.ErrorIs(err, errBadRequest)
+}
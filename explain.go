@@ -0,0 +1,27 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Explain returns the SQL and the vars of the query FilterByQuery would build for the model,
+// without executing it, e.g. for the diagnostics endpoints.
+// Example:
+//
+//	sql, vars, err := filter.Explain(c, db, &User{}, filter.ALL)
+func Explain(c *gin.Context, db *gorm.DB, model interface{}, config int, opts ...Option) (string, []interface{}, error) {
+	dest := model
+	if modelType := structType(model); modelType != nil {
+		dest = reflect.New(reflect.SliceOf(modelType)).Interface()
+	}
+	tx := db.Session(&gorm.Session{DryRun: true}).Model(model).Scopes(FilterByQuery(c, config, opts...)).Find(dest)
+	return tx.Statement.SQL.String(), tx.Statement.Vars, tx.Error
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"database/sql/driver"
+	"errors"
+	"net/http/httptest"
+	"regexp"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestExplain is a test for the SQL of the query matching the executed one.
+func (s *TestSuite) TestExplain() {
+	tests := []struct {
+		query    string
+		config   int
+		expected string
+		vars     []interface{}
+	}{
+		{"filter=login:sampleUser", ALL,
+			`^SELECT \* FROM "users" WHERE "users"."username" = \$1 ORDER BY "id" DESC LIMIT \$2$`, []interface{}{"sampleUser", 10}},
+		{"search=John&filter=login:sampleUser", SEARCH | FILTER,
+			`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) AND "users"."username" = \$3$`,
+			[]interface{}{"%john%", "%john%", "sampleUser"}},
+		{"page=2&page_size=20&order_by=email&order_direction=asc", PAGINATE | ORDER_BY,
+			`^SELECT \* FROM "users" ORDER BY "email" LIMIT \$1 OFFSET \$2$`, []interface{}{20, 20}},
+	}
+
+	for _, test := range tests {
+		s.Run(test.query, func() {
+			ctx := gin.Context{Request: httptest.NewRequest("GET", "/users?"+test.query, nil)}
+			sql, vars, err := Explain(&ctx, s.db, &User{}, test.config)
+			s.Require().NoError(err)
+			s.Regexp(regexp.MustCompile(test.expected), sql)
+			s.Equal(test.vars, vars)
+
+			var users []User
+			args := make([]driver.Value, 0, len(vars))
+			for _, v := range vars {
+				args = append(args, v)
+			}
+			s.mock.ExpectQuery(test.expected).WithArgs(args...).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			s.NoError(s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, test.config)).Find(&users).Error)
+			s.NoError(s.mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestExplainError is a test for the malformed query parameters of the explained query.
+func (s *TestSuite) TestExplainError() {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest("GET", "/users?page=two", nil)
+	_, _, err := Explain(ctx, s.db, &User{}, ALL)
+	var filterErr *Error
+	s.True(errors.As(err, &filterErr))
+}
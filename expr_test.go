@@ -0,0 +1,55 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+type Person struct {
+	Id          uint
+	Nickname    *string
+	FullName    string
+	DisplayName string `gorm:"-" filter:"param:display_name;filterable;sortable;expr:COALESCE(nickname, full_name)"`
+}
+
+// TestFilterExpr is a test for filtering and ordering by the expressions of the fields.
+func (s *TestSuite) TestFilterExpr() {
+	tests := []struct {
+		query    string
+		config   int
+		opts     []Option
+		expected string
+	}{
+		{"filter=display_name:John", FILTER, nil, `WHERE COALESCE\(nickname, full_name\) = \$1`},
+		{"filter=display_name~John", FILTER, []Option{WithInsensitiveLike()}, `WHERE COALESCE\(nickname, full_name\) ILIKE \$1`},
+		{"filter=display_name!=John&order_by=display_name&order_direction=asc", FILTER | ORDER_BY, nil,
+			`WHERE COALESCE\(nickname, full_name\) <> \$1 ORDER BY COALESCE\(nickname, full_name\)`},
+		{"order_by=Display_Name&order_direction=asc", ORDER_BY, nil, `ORDER BY COALESCE\(nickname, full_name\)`},
+	}
+	for _, test := range tests {
+		var people []Person
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/people?"+test.query, nil)}
+		s.mock.ExpectQuery(`^SELECT \* FROM "people" ` + test.expected + `$`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "nickname", "full_name"}))
+		err := s.db.Model(&Person{}).Scopes(FilterByQuery(&ctx, test.config, test.opts...)).Find(&people).Error
+		s.NoError(err, test.query)
+	}
+
+	// The expressions are ordered by without the filters too.
+	var people []Person
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/people?order_by=display_name", nil)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "people" ORDER BY COALESCE\(nickname, full_name\) DESC$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "nickname", "full_name"}))
+	err := s.db.Model(&Person{}).Scopes(FilterByQuery(&ctx, ORDER_BY)).Find(&people).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+	s.NoError(Validate(&Person{}))
+}
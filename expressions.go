@@ -0,0 +1,137 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+
+	"gorm.io/gorm/clause"
+)
+
+// builtQuery holds the conditions built from the query, applied by the scopes or returned by BuildExpressions.
+type builtQuery struct {
+	where     []clause.Expression
+	having    clause.Expression
+	relevance clause.Expression // order of the searched rows with WithRelevanceOrder
+	searched  bool              // there are searchable fields matching the phrase
+}
+
+// buildConditions resolves the query against the model fields and builds the search, filter, cursor and ids
+// conditions. The meta is nil if the model isn't introspected, then only the cursor is applied.
+func (o *options) buildConditions(table string, meta *modelMeta, query *Query) (builtQuery, error) {
+	var built builtQuery
+	if meta != nil {
+		if err := query.resolve(meta, o); err != nil {
+			return built, err
+		}
+		if query.Search != "" {
+			so := o.withSearchCase(query.SearchCase)
+			if expression := so.searchExpression(meta, query.Search); expression != nil {
+				built.where = append(built.where, expression)
+				built.searched = true
+			}
+			if o.relevanceOrder && !query.explicitOrder {
+				built.relevance = so.relevanceExpression(meta.fields, query.Search)
+			}
+		}
+		filters, columns, having, havingColumns := splitHaving(query.Filters, query.columns)
+		predicate := clause.And
+		var (
+			defaults       []Condition
+			defaultColumns []interface{}
+		)
+		if query.filterOr {
+			// The group is parenthesized, so it's ANDed with the search and the other conditions as a whole.
+			predicate = func(exprs ...clause.Expression) clause.Expression { return clause.And(clause.Or(exprs...)) }
+			// The default values aren't the client's filters, so they restrict the group rather than widen it.
+			filters, columns, defaults, defaultColumns = splitDefaults(filters, columns)
+		}
+		if expression := o.filterExpressions(filters, columns, predicate); expression != nil {
+			built.where = append(built.where, expression)
+		}
+		if expression := o.filterExpressions(defaults, defaultColumns, clause.And); expression != nil {
+			built.where = append(built.where, expression)
+		}
+		built.having = o.filterExpressions(having, havingColumns, clause.And)
+	}
+	if query.Cursor != nil {
+		built.where = append(built.where, cursorExpression(table, meta, query.Cursor, o))
+	}
+	if query.IDs != nil {
+		expression, err := idsExpression(table, meta, query.IDs)
+		if err != nil {
+			return built, err
+		}
+		built.where = append(built.where, expression)
+	}
+	return built, nil
+}
+
+// checkRequired rejects the query without the conditions on the required params.
+func (o *options) checkRequired(query Query) error {
+	for _, param := range o.required {
+		if !slices.ContainsFunc(query.Filters, func(condition Condition) bool { return condition.Param == param }) {
+			return &Error{Param: "filter", Reason: fmt.Sprintf("condition on %s is required", param)}
+		}
+	}
+	return nil
+}
+
+// BuildExpressions parses the query parameters into the conditions, the order and the page FilterByQuery
+// applies, leaving it to the caller to apply them, e.g. in a subquery, an EXISTS check or another ORM session.
+// The model is introspected with the default naming strategy and the forced conditions get the nil context.
+// The HAVING conditions and the relevance order aren't built, the limit is 0 without the pagination.
+// Example:
+//
+//	where, order, limit, offset, err := filter.BuildExpressions(c.Request.URL.Query(), &User{}, filter.ALL)
+//	db.Model(&User{}).Where(clause.And(where...)).Order(clause.OrderBy{Columns: order}).Limit(limit).Offset(offset)
+func BuildExpressions(values url.Values, model interface{}, config int, opts ...Option) (
+	where []clause.Expression, order []clause.OrderByColumn, limit, offset int, err error,
+) {
+	o := newOptions(opts)
+	c := ConfigFromBits(config)
+	query, err := parseQuery(values, c, o)
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+	table, meta, ok := defaultQueryMeta(model, o)
+	if !ok {
+		return nil, nil, 0, 0, fmt.Errorf("filter: can't build the expressions of %T, model must be a struct", model)
+	}
+	built, err := o.buildConditions(table, meta, &query)
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+	if err := o.checkRequired(query); err != nil {
+		return nil, nil, 0, 0, err
+	}
+	where = built.where
+	if o.forcedConditions != nil {
+		where = append(where, o.forcedConditions(nil)...)
+	}
+
+	switch {
+	case query.Cursor != nil:
+		order = cursorOrderColumns(meta, query.Cursor, o)
+	case c.OrderBy:
+		if query.explicitOrder && !o.caseSensitive {
+			foldOrder(meta, &query)
+		}
+		if len(o.orderFallback) > 0 {
+			if err := fallbackOrder(meta, &query, o); err != nil {
+				return nil, nil, 0, 0, err
+			}
+		}
+		order = orderColumns(meta, query)
+	}
+	if c.Paginate && !query.All {
+		limitPageSize(model, &query, o)
+		limit, offset = query.PageSize, (query.Page-1)*query.PageSize
+	}
+	return where, order, limit, offset, nil
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/url"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TestBuildExpressions is a test for building the clauses of the query without applying them.
+func (s *TestSuite) TestBuildExpressions() {
+	values := url.Values{
+		"filter":   {"login:sampleUser"},
+		"search":   {"John"},
+		"order_by": {"email,id"},
+		"page":     {"3"},
+	}
+	where, order, limit, offset, err := BuildExpressions(values, &User{}, ALL)
+	s.NoError(err)
+	s.Len(where, 2)
+	s.Equal([]clause.OrderByColumn{{Column: clause.Column{Name: "email"}, Desc: true}, {Column: clause.Column{Name: "id"}, Desc: true}}, order)
+	s.Equal(10, limit)
+	s.Equal(20, offset)
+
+	// The expressions are applied manually, e.g. in an EXISTS subquery.
+	var exists bool
+	s.mock.ExpectQuery(`^SELECT EXISTS\(SELECT 1 FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) `+
+		`AND "users"."username" = \$3\)$`).
+		WithArgs("%john%", "%john%", "sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	subquery := s.db.Session(&gorm.Session{NewDB: true}).Model(&User{}).Select("1").Where(clause.And(where...))
+	err = s.db.Raw("SELECT EXISTS(?)", subquery).Scan(&exists).Error
+	s.NoError(err)
+	s.True(exists)
+
+	var users []User
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) `+
+		`AND "users"."username" = \$3 ORDER BY "email" DESC,"id" DESC LIMIT \$4 OFFSET \$5$`).
+		WithArgs("%john%", "%john%", "sampleUser", 10, 20).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err = s.db.Model(&User{}).Where(clause.And(where...)).Order(clause.OrderBy{Columns: order}).
+		Limit(limit).Offset(offset).Find(&users).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	// The errors are the ones of FilterByQuery.
+	_, _, _, _, err = BuildExpressions(url.Values{"filter": {"login:bob"}}, &User{}, FILTER, WithRequired("email"))
+	s.Equal(&Error{Param: "filter", Reason: "condition on email is required"}, err)
+	_, _, _, _, err = BuildExpressions(url.Values{"page": {"two"}}, &User{}, PAGINATE)
+	s.Equal(&Error{Param: "page", Value: "two", Reason: "must be an integer"}, err)
+	_, _, _, _, err = BuildExpressions(url.Values{}, "users", ALL)
+	s.EqualError(err, "filter: can't build the expressions of string, model must be a struct")
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// selectColumns returns the columns selected with the fields param and the JSON:API sparse fieldsets.
+func selectColumns(db *gorm.DB, query Query, o *options) ([]string, error) {
+	var columns []string
+	if len(query.Fieldsets) > 0 {
+		fieldset, err := fieldsetColumns(db, query, o)
+		if err != nil {
+			return nil, err
+		}
+		columns = fieldset
+	}
+	var (
+		fields []string
+		err    error
+	)
+	switch {
+	case len(query.Fields) > 0:
+		fields, err = fieldsColumns(db, "fields", query.Fields, o)
+	case len(query.ExcludeFields) > 0:
+		fields, err = excludeFieldsColumns(db, query.ExcludeFields, o)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, column := range fields {
+		columns = appendColumn(columns, column)
+	}
+	return columns, nil
+}
+
+// fieldsColumns returns the columns of the selectable fields requested with the fields param, the primary
+// key columns are always included. Unknown and not selectable fields are ignored or rejected in the strict mode,
+// nothing is selected if none of the fields is selectable. The key is the query param the fields are listed in.
+func fieldsColumns(db *gorm.DB, key string, params []string, o *options) ([]string, error) {
+	_, meta, ok := queryMeta(db, o)
+	if !ok {
+		return nil, nil
+	}
+
+	var requested []string
+	for _, param := range params {
+		found := false
+		for _, field := range meta.fields {
+			if field.Selectable && o.paramMatches(field.param, param) {
+				requested = appendColumn(requested, field.Column)
+				found = true
+				break
+			}
+		}
+		if !found && o.strict {
+			return nil, &Error{Param: key, Value: param, Reason: "unknown field"}
+		}
+	}
+	if len(requested) == 0 {
+		return nil, nil
+	}
+
+	columns := make([]string, 0, len(meta.primaryKeys)+len(requested))
+	columns = append(columns, meta.primaryKeys...)
+	for _, column := range requested {
+		columns = appendColumn(columns, column)
+	}
+	return columns, nil
+}
+
+// excludeFieldsColumns returns the primary key and the selectable columns except the ones excluded with
+// the exclude_fields param, the omitted fields aren't selected. Excluding the primary key is silently refused, unknown and not selectable
+// fields are ignored or rejected in the strict mode. Nothing is selected if none of the fields is excluded.
+func excludeFieldsColumns(db *gorm.DB, params []string, o *options) ([]string, error) {
+	_, meta, ok := queryMeta(db, o)
+	if !ok {
+		return nil, nil
+	}
+
+	var excluded []string
+	for _, param := range params {
+		index := slices.IndexFunc(meta.fields, func(field fieldMeta) bool { return o.paramMatches(field.param, param) })
+		switch {
+		case index >= 0 && slices.Contains(meta.primaryKeys, meta.fields[index].Column):
+			// The primary key is always selected.
+		case index >= 0 && meta.fields[index].Selectable:
+			excluded = append(excluded, meta.fields[index].Column)
+		case o.strict:
+			return nil, &Error{Param: "exclude_fields", Value: param, Reason: "unknown field"}
+		}
+	}
+	if len(excluded) == 0 {
+		return nil, nil
+	}
+
+	columns := append(make([]string, 0, len(meta.primaryKeys)+len(meta.fields)), meta.primaryKeys...)
+	for _, field := range meta.fields {
+		if field.Selectable && !field.Omit && !slices.Contains(excluded, field.Column) {
+			columns = appendColumn(columns, field.Column)
+		}
+	}
+	return columns, nil
+}
+
+// defaultColumns returns the columns selected by default, nil if no fields of the model are omitted.
+func defaultColumns(db *gorm.DB, o *options) []string {
+	modelType := structType(statementModel(db, o))
+	if modelType == nil || !omitsFields(modelType) {
+		return nil
+	}
+	if _, meta, ok := queryMeta(db, o); ok {
+		return meta.defaultColumns
+	}
+	return nil
+}
+
+// isCount reports whether the statement is the Count one.
+func isCount(db *gorm.DB) bool {
+	_, ok := db.Statement.Dest.(*int64)
+	return ok
+}
+
+// multipliesRows reports whether the statement joins the has-many or many2many relations of the model by name,
+// multiplying the rows. The model is only introspected if the statement has joins.
+func multipliesRows(db *gorm.DB, o *options) bool {
+	if len(db.Statement.Joins) == 0 {
+		return false
+	}
+	_, meta, ok := queryMeta(db, o)
+	if !ok {
+		return false
+	}
+	for _, join := range db.Statement.Joins {
+		relation, _, _ := strings.Cut(join.Name, ".")
+		if slices.Contains(meta.multiplying, relation) {
+			return true
+		}
+	}
+	return false
+}
+
+// distinct selects the distinct rows of the columns, the default ones if there are none. The count
+// statements count the distinct primary keys instead, so the totals match the returned rows.
+func distinct(db *gorm.DB, columns []string, o *options) *gorm.DB {
+	if isCount(db) {
+		if _, meta, ok := queryMeta(db, o); ok && len(meta.primaryKeys) == 1 {
+			primaryKey := clause.Column{Table: clause.CurrentTable, Name: meta.primaryKeys[0]}
+			db.Statement.AddClause(clause.Select{Expression: clause.Expr{SQL: "COUNT(DISTINCT(?))", Vars: []interface{}{primaryKey}}})
+		}
+		return db
+	}
+
+	if len(columns) == 0 && len(db.Statement.Selects) == 0 {
+		columns = defaultColumns(db, o)
+	}
+	args := make([]interface{}, 0, len(columns))
+	for _, column := range columns {
+		args = append(args, column)
+	}
+	return db.Distinct(args...)
+}
+
+// appendColumn appends the column unless it's already selected.
+func appendColumn(columns []string, column string) []string {
+	if slices.Contains(columns, column) {
+		return columns
+	}
+	return append(columns, column)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type Profile struct {
+	Id       uint   `filter:"param:id"`
+	Username string `filter:"param:login;selectable;filterable"`
+	Email    string `filter:"selectable"`
+	Password string
+}
+
+// TestFields is a test for the columns selected with the fields param.
+func (s *TestSuite) TestFields() {
+	tests := []struct {
+		query    string
+		config   Config
+		expected string
+	}{
+		{"fields=login,email", Config{Fields: true}, `SELECT "id","username","email" FROM "profiles"`},
+		{"fields=email&fields=id,login", Config{Fields: true}, `SELECT "id","email","username" FROM "profiles"`},
+		{"fields=password,email,email", Config{Fields: true}, `SELECT "id","email" FROM "profiles"`},
+		{"fields=password,unknown", Config{Fields: true}, `SELECT * FROM "profiles"`},
+		{"fields=login", Config{Filter: true}, `SELECT * FROM "profiles"`},
+		{"exclude_fields=email", Config{Fields: true}, `SELECT "id","username" FROM "profiles"`},
+		{"exclude_fields=id,email", Config{Fields: true}, `SELECT "id","username" FROM "profiles"`},
+		{"exclude_fields=id", Config{Fields: true}, `SELECT * FROM "profiles"`},
+		{"fields=email&exclude_fields=email", Config{Fields: true}, `SELECT "id","email" FROM "profiles"`},
+	}
+
+	dryRun := s.db.Session(&gorm.Session{DryRun: true})
+	for _, test := range tests {
+		s.Run(test.query, func() {
+			var profiles []Profile
+			ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/profiles?"+test.query, nil)}
+			statement := dryRun.Model(&Profile{}).Scopes(FilterByQueryConfig(&ctx, test.config)).Find(&profiles).Statement
+			s.Equal(test.expected, statement.SQL.String())
+		})
+	}
+}
+
+// TestFieldsStrict is a test for rejecting the fields which can't be selected in the strict mode.
+func (s *TestSuite) TestFieldsStrict() {
+	var profiles []Profile
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/profiles?fields=login,password", nil)
+	err := s.db.Session(&gorm.Session{DryRun: true}).Model(&Profile{}).
+		Scopes(FilterByQueryConfig(ctx, Config{Fields: true}, WithStrict())).Find(&profiles).Error
+
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal("fields", filterErr.Param)
+	s.Equal("password", filterErr.Value)
+	s.Equal(http.StatusBadRequest, ctx.Writer.Status())
+}
+
+// TestDistinct is a test for the distinct rows and counts of the joined query.
+func (s *TestSuite) TestDistinct() {
+	var (
+		users []User
+		count int64
+	)
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?filter=login:sampleUser&page=2", nil)}
+	dryRun := s.db.Session(&gorm.Session{DryRun: true})
+	joined := func(config Config) *gorm.DB {
+		return dryRun.Model(&User{}).Joins("JOIN orders ON orders.user_id = users.id").Scopes(FilterByQueryConfig(&ctx, config))
+	}
+
+	statement := joined(Config{Filter: true, Distinct: true}).Count(&count).Statement
+	s.Equal(`SELECT COUNT(DISTINCT("users"."id")) FROM "users" JOIN orders ON orders.user_id = users.id `+
+		`WHERE "users"."username" = $1`, statement.SQL.String())
+
+	statement = joined(Config{Filter: true, Paginate: true, Distinct: true}).Find(&users).Statement
+	s.Equal(`SELECT DISTINCT "users"."id","users"."username","users"."full_name","users"."email","users"."organization_id","users"."password" `+
+		`FROM "users" JOIN orders ON orders.user_id = users.id WHERE "users"."username" = $1 LIMIT $2 OFFSET $3`, statement.SQL.String())
+}
+
+// TestDistinctFields is a test for the distinct rows of the selected columns.
+func (s *TestSuite) TestDistinctFields() {
+	var profiles []Profile
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/profiles?fields=email", nil)}
+	statement := s.db.Session(&gorm.Session{DryRun: true}).Model(&Profile{}).
+		Scopes(FilterByQueryConfig(&ctx, Config{Fields: true, Distinct: true})).Find(&profiles).Statement
+	s.Equal(`SELECT DISTINCT "id","email" FROM "profiles"`, statement.SQL.String())
+}
+
+type Document struct {
+	Id      uint   `filter:"param:id;filterable"`
+	Title   string `filter:"param:title;selectable"`
+	Body    string `filter:"param:body;selectable;omit"`
+	Preview []byte `filter:"omit"`
+}
+
+// TestFieldsOmit is a test for the omitted columns left out of the default selection.
+func (s *TestSuite) TestFieldsOmit() {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"", `SELECT "id","title" FROM "documents"`},
+		{"fields=title,body", `SELECT "id","title","body" FROM "documents"`},
+		{"fields=preview", `SELECT "id","title" FROM "documents"`},
+		{"exclude_fields=title", `SELECT "id" FROM "documents"`},
+	}
+
+	dryRun := s.db.Session(&gorm.Session{DryRun: true})
+	for _, test := range tests {
+		s.Run(test.query, func() {
+			var documents []Document
+			ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/documents?"+test.query, nil)}
+			statement := dryRun.Model(&Document{}).Scopes(FilterByQueryConfig(&ctx, Config{Fields: true})).Find(&documents).Statement
+			s.Equal(test.expected, statement.SQL.String())
+		})
+	}
+
+	var documents []Document
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/documents", nil)}
+	statement := dryRun.Model(&Document{}).Select("preview").Scopes(FilterByQueryConfig(&ctx, Config{Fields: true})).Find(&documents).Statement
+	s.Equal(`SELECT "preview" FROM "documents"`, statement.SQL.String())
+
+	var count int64
+	ctx = gin.Context{Request: httptest.NewRequest(http.MethodGet, "/documents?fields=title", nil)}
+	statement = dryRun.Model(&Document{}).Scopes(FilterByQueryConfig(&ctx, Config{Fields: true})).Count(&count).Statement
+	s.Equal(`SELECT count(*) FROM "documents"`, statement.SQL.String())
+}
+
+// TestDistinctCount is a test for counting the distinct primary keys of the joins multiplying the rows.
+func (s *TestSuite) TestDistinctCount() {
+	var count int64
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/organizations?filter=id:1", nil)}
+	dryRun := s.db.Session(&gorm.Session{DryRun: true})
+
+	statement := dryRun.Model(&Organization{}).Scopes(FilterByQuery(&ctx, FILTER)).Count(&count).Statement
+	s.Equal(`SELECT count(*) FROM "organizations" WHERE "organizations"."id" = $1`, statement.SQL.String())
+
+	statement = dryRun.Model(&Organization{}).Joins("Users").Scopes(FilterByQuery(&ctx, FILTER)).Count(&count).Statement
+	s.Contains(statement.SQL.String(), `SELECT COUNT(DISTINCT("organizations"."id")) FROM "organizations" LEFT JOIN "users" "Users"`)
+
+	statement = dryRun.Model(&Organization{}).Joins("JOIN users ON users.organization_id = organizations.id").
+		Scopes(FilterByQuery(&ctx, FILTER)).Count(&count).Statement
+	s.Equal(`SELECT count(*) FROM "organizations" JOIN users ON users.organization_id = organizations.id `+
+		`WHERE "organizations"."id" = $1`, statement.SQL.String())
+
+	statement = dryRun.Model(&Organization{}).Joins("JOIN users ON users.organization_id = organizations.id").
+		Scopes(FilterByQuery(&ctx, FILTER, WithDistinctCount())).Count(&count).Statement
+	s.Equal(`SELECT COUNT(DISTINCT("organizations"."id")) FROM "organizations" JOIN users ON users.organization_id = organizations.id `+
+		`WHERE "organizations"."id" = $1`, statement.SQL.String())
+}
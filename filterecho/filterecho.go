@@ -0,0 +1,34 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package filterecho adapts the filter to the Echo framework.
+package filterecho
+
+import (
+	"net/http"
+
+	filter "github.com/ActiveChooN/gin-gorm-filter"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// FilterByQuery filters DB request with the echo query parameters, see filter.FilterByQuery.
+// Malformed query parameters, or the ones rejected with filter.WithStrict, are added to the DB error
+// as *echo.HTTPError with the 400 status code, wrapping the *filter.Error.
+// Example:
+//
+//	err := db.Model(&UserModel{}).Scopes(filterecho.FilterByQuery(c, filter.ALL, filter.WithStrict())).Find(&users).Error
+//	var httpErr *echo.HTTPError
+//	if errors.As(err, &httpErr) {
+//		return httpErr
+//	}
+func FilterByQuery(c echo.Context, config int, opts ...filter.Option) func(db *gorm.DB) *gorm.DB {
+	opts = append([]filter.Option{filter.WithErrorHandler(httpError)}, opts...)
+	return filter.FilterByValues(c.QueryParams(), config, opts...)
+}
+
+func httpError(err error) error {
+	return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filterecho
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	filter "github.com/ActiveChooN/gin-gorm-filter"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type User struct {
+	Id       uint   `filter:"param:id;filterable"`
+	Username string `filter:"param:login;searchable;filterable"`
+	FullName string `filter:"param:name;searchable"`
+	Email    string `filter:"filterable"`
+	// This field is not filtered.
+	Password string
+}
+
+type TestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	mock sqlmock.Sqlmock
+}
+
+func (s *TestSuite) SetupTest() {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, s.mock, err = sqlmock.New()
+	s.NoError(err)
+
+	dialector := postgres.New(postgres.Config{
+		DSN:                  "sqlmock_db_0",
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	s.db, err = gorm.Open(dialector, &gorm.Config{})
+	require.NoError(s.T(), err)
+}
+
+func (s *TestSuite) TearDownTest() {
+	db, err := s.db.DB()
+	require.NoError(s.T(), err)
+	db.Close()
+}
+
+func newContext(target string) echo.Context {
+	return echo.New().NewContext(httptest.NewRequest(http.MethodGet, target, nil), httptest.NewRecorder())
+}
+
+// TestFiltersBasic is a test for basic filters functionality.
+func (s *TestSuite) TestFiltersBasic() {
+	var users []User
+	c := newContext("/users?filter=login:sampleUser")
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1$`).
+		WithArgs("sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(c, filter.FILTER)).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestSearch is a test for search functionality.
+func (s *TestSuite) TestSearch() {
+	var users []User
+	c := newContext("/users?search=John")
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\)$`).
+		WithArgs("%john%", "%john%").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(c, filter.SEARCH)).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestPaginate is a test for pagination functionality.
+func (s *TestSuite) TestPaginate() {
+	var users []User
+	c := newContext("/users?page=2&page_size=10")
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" ORDER BY "id" DESC LIMIT \$1 OFFSET \$2$`).
+		WithArgs(10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(c, filter.PAGINATE|filter.ORDER_BY)).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestStrictError is a test for reporting the rejected query parameters as the echo HTTP error.
+func (s *TestSuite) TestStrictError() {
+	var users []User
+	c := newContext("/users?filter=password:secret")
+
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(c, filter.ALL, filter.WithStrict())).Find(&users).Error
+	var httpErr *echo.HTTPError
+	s.Require().True(errors.As(err, &httpErr))
+	s.Equal(http.StatusBadRequest, httpErr.Code)
+	var filterErr *filter.Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal("filter", filterErr.Param)
+	s.Equal("password:secret", filterErr.Value)
+}
+
+func TestRunSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package filterfiber adapts the filter to the Fiber framework.
+package filterfiber
+
+import (
+	"net/url"
+
+	filter "github.com/ActiveChooN/gin-gorm-filter"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// FilterByQuery filters DB request with the fiber query parameters, see filter.FilterByQuery.
+// The query parameters are copied, so the scope could be applied after the handler returns.
+// Malformed query parameters, or the ones rejected with filter.WithStrict, are added to the DB error
+// as *fiber.Error with the 400 status code.
+// Example:
+//
+//	err := db.Model(&UserModel{}).Scopes(filterfiber.FilterByQuery(c, filter.ALL)).Find(&users).Error
+func FilterByQuery(c *fiber.Ctx, config int, opts ...filter.Option) func(db *gorm.DB) *gorm.DB {
+	opts = append([]filter.Option{filter.WithErrorHandler(badRequest)}, opts...)
+	return filter.FilterByValues(queryValues(c), config, opts...)
+}
+
+// queryValues copies the query parameters out of the pooled fasthttp request.
+func queryValues(c *fiber.Ctx) url.Values {
+	values := url.Values{}
+	c.Request().URI().QueryArgs().VisitAll(func(key, value []byte) {
+		values.Add(string(key), string(value))
+	})
+	return values
+}
+
+func badRequest(err error) error {
+	return fiber.NewError(fiber.StatusBadRequest, err.Error())
+}
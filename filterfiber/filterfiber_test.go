@@ -0,0 +1,120 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filterfiber
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	filter "github.com/ActiveChooN/gin-gorm-filter"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/valyala/fasthttp"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type User struct {
+	Id       uint   `filter:"param:id;filterable"`
+	Username string `filter:"param:login;searchable;filterable"`
+	FullName string `filter:"param:name;searchable"`
+	Email    string `filter:"filterable"`
+	// This field is not filtered.
+	Password string
+}
+
+type TestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	mock sqlmock.Sqlmock
+	app  *fiber.App
+}
+
+func (s *TestSuite) SetupTest() {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, s.mock, err = sqlmock.New()
+	s.NoError(err)
+
+	dialector := postgres.New(postgres.Config{
+		DSN:                  "sqlmock_db_0",
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	s.db, err = gorm.Open(dialector, &gorm.Config{})
+	require.NoError(s.T(), err)
+	s.app = fiber.New()
+}
+
+func (s *TestSuite) TearDownTest() {
+	db, err := s.db.DB()
+	require.NoError(s.T(), err)
+	db.Close()
+}
+
+// scope builds the scope within the pooled fiber context, released before the scope is applied.
+func (s *TestSuite) scope(uri string, config int, opts ...filter.Option) func(db *gorm.DB) *gorm.DB {
+	requestCtx := &fasthttp.RequestCtx{}
+	requestCtx.Request.SetRequestURI(uri)
+	c := s.app.AcquireCtx(requestCtx)
+	defer s.app.ReleaseCtx(c)
+	defer requestCtx.Request.Reset()
+	return FilterByQuery(c, config, opts...)
+}
+
+// TestFiltersBasic is a test for basic filters functionality.
+func (s *TestSuite) TestFiltersBasic() {
+	var users []User
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1 AND "users"."email" = \$2$`).
+		WithArgs("sampleUser", "john@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(s.scope("/users?filter=login:sampleUser&filter=email:john@example.com", filter.FILTER)).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestSearch is a test for search functionality.
+func (s *TestSuite) TestSearch() {
+	var users []User
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\)$`).
+		WithArgs("%john%", "%john%").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(s.scope("/users?search=John", filter.SEARCH)).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestOrderBy is a test for order by functionality.
+func (s *TestSuite) TestOrderBy() {
+	var users []User
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" ORDER BY "email"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(s.scope("/users?order_by=Email&order_direction=asc", filter.ORDER_BY)).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestError is a test for reporting the malformed query parameters as the fiber error.
+func (s *TestSuite) TestError() {
+	var users []User
+
+	err := s.db.Model(&User{}).Scopes(s.scope("/users?page=first", filter.ALL)).Find(&users).Error
+	var fiberErr *fiber.Error
+	s.Require().True(errors.As(err, &fiberErr))
+	s.Equal(fiber.StatusBadRequest, fiberErr.Code)
+}
+
+func TestRunSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestFilterCombinator is a test for ORing the filter params.
+func (s *TestSuite) TestFilterCombinator() {
+	tests := []struct {
+		query    string
+		opts     []Option
+		expected string
+	}{
+		{"filter=login:bob&filter=email:bob@example.com&filter_logic=or", nil,
+			`WHERE \("users"."username" = \$1 OR "users"."email" = \$2\)`},
+		{"filter=login:bob&filter=email:bob@example.com&search=John&filter_logic=or", nil,
+			`WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) AND \("users"."username" = \$3 OR "users"."email" = \$4\)`},
+		{"filter=login:bob&filter=email:bob@example.com&filter_logic=or", []Option{WithForcedConditions(tenantConditions)},
+			`WHERE \("users"."username" = \$1 OR "users"."email" = \$2\) AND "users"."organization_id" = \$3`},
+		{"filter=login:bob,id>1&filter=email:bob@example.com", []Option{WithFilterCombinator(Or)},
+			`WHERE \(\("users"."id" > \$1 AND "users"."username" = \$2\) OR "users"."email" = \$3\)`},
+		{"filter=login:bob&filter=email:bob@example.com&filter_logic=and", []Option{WithFilterCombinator(Or)},
+			`WHERE "users"."username" = \$1 AND "users"."email" = \$2`},
+		{"filter=login:bob&filter_logic=or", nil, `WHERE "users"."username" = \$1`},
+	}
+	for _, test := range tests {
+		var users []User
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?"+test.query, nil)}
+		s.mock.ExpectQuery(`^SELECT \* FROM "users" ` + test.expected + `$`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+		err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, SEARCH|FILTER, test.opts...)).Find(&users).Error
+		s.NoError(err, test.query)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	// The default values are ANDed with the group of the client's filters.
+	var listings []Listing
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/listings?filter=title:Flat&filter=title:House&filter_logic=or", nil)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "listings" WHERE \("listings"."title" = \$1 OR "listings"."title" = \$2\) AND "listings"."status" = \$3$`).
+		WithArgs("Flat", "House", "active").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "status"}))
+	err := s.db.Model(&Listing{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&listings).Error
+	s.NoError(err)
+
+	_, err = ParseQuery(map[string][]string{"filter_logic": {"xor"}}, &User{}, FILTER)
+	s.Equal(&Error{Param: "filter_logic", Value: "xor", Reason: "must be and or or"}, err)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package filterotel describes the applied filters in the OpenTelemetry spans.
+package filterotel
+
+import (
+	"unicode/utf8"
+
+	filter "github.com/ActiveChooN/gin-gorm-filter"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// redacted replaces the filter values of the fields tagged as `pii`.
+const redacted = "[REDACTED]"
+
+// WithSpanAttributes sets the attributes describing the applied query on the span of the request context,
+// if there is a recording one:
+//   - filter.fields, filter.operators and filter.values of the filter conditions, the values of the fields
+//     tagged as `pii` are redacted
+//   - filter.search_len, the length of the search phrase in characters
+//   - filter.page and filter.page_size of the paginated queries
+//   - filter.order_by, the ordered columns with the directions, e.g. "created_at desc"
+//
+// It's added as the audit hook, so it's called once per request along with the other hooks.
+// Example:
+//
+//	db.Model(&User{}).Scopes(filter.FilterByQuery(c, filter.ALL, filterotel.WithSpanAttributes())).Find(&users)
+func WithSpanAttributes() filter.Option {
+	return filter.WithAuditHook(setAttributes)
+}
+
+func setAttributes(c *gin.Context, query filter.Query) {
+	if c == nil || c.Request == nil {
+		return
+	}
+	span := trace.SpanFromContext(c.Request.Context())
+	if !span.IsRecording() {
+		return
+	}
+	span.SetAttributes(attributes(query)...)
+}
+
+func attributes(query filter.Query) []attribute.KeyValue {
+	fields := make([]string, 0, len(query.Filters))
+	operators := make([]string, 0, len(query.Filters))
+	values := make([]string, 0, len(query.Filters))
+	for _, condition := range query.Filters {
+		fields = append(fields, condition.Param)
+		operators = append(operators, condition.Operator)
+		if condition.PII {
+			values = append(values, redacted)
+		} else {
+			values = append(values, condition.Value)
+		}
+	}
+	attrs := []attribute.KeyValue{
+		attribute.StringSlice("filter.fields", fields),
+		attribute.StringSlice("filter.operators", operators),
+		attribute.StringSlice("filter.values", values),
+		attribute.Int("filter.search_len", utf8.RuneCountInString(query.Search)),
+	}
+	if query.Page > 0 {
+		attrs = append(attrs, attribute.Int("filter.page", query.Page), attribute.Int("filter.page_size", query.PageSize))
+	}
+	if query.OrderBy != "" {
+		orders := append([]filter.Order{{Column: query.OrderBy, Desc: query.OrderDesc}}, query.ThenBy...)
+		orderBy := make([]string, 0, len(orders))
+		for _, order := range orders {
+			if order.Desc {
+				orderBy = append(orderBy, order.Column+" desc")
+			} else {
+				orderBy = append(orderBy, order.Column+" asc")
+			}
+		}
+		attrs = append(attrs, attribute.StringSlice("filter.order_by", orderBy))
+	}
+	return attrs
+}
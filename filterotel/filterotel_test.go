@@ -0,0 +1,109 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filterotel
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	filter "github.com/ActiveChooN/gin-gorm-filter"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type User struct {
+	Id       uint   `filter:"param:id;filterable"`
+	Username string `filter:"param:login;searchable;filterable"`
+	FullName string `filter:"param:name;searchable"`
+	Email    string `filter:"filterable;pii"`
+	// This field is not filtered.
+	Password string
+}
+
+type TestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	mock sqlmock.Sqlmock
+}
+
+func (s *TestSuite) SetupTest() {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	db, s.mock, err = sqlmock.New()
+	s.NoError(err)
+
+	dialector := postgres.New(postgres.Config{
+		DSN:                  "sqlmock_db_0",
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	s.db, err = gorm.Open(dialector, &gorm.Config{})
+	require.NoError(s.T(), err)
+}
+
+func (s *TestSuite) TearDownTest() {
+	db, err := s.db.DB()
+	require.NoError(s.T(), err)
+	db.Close()
+}
+
+// TestSpanAttributes is a test for describing the applied query in the span of the request.
+func (s *TestSuite) TestSpanAttributes() {
+	var users []User
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	ctx, span := provider.Tracer("test").Start(httptest.NewRequest(http.MethodGet, "/", nil).Context(), "list users")
+
+	r := httptest.NewRequest(http.MethodGet, "/users?search=Jöhn&filter=login:sampleUser,email:john@example.com&page=2&order_by=email", nil)
+	c := &gin.Context{Request: r.WithContext(ctx)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(filter.FilterByQuery(c, filter.ALL, WithSpanAttributes())).Find(&users).Error
+	s.NoError(err)
+	span.End()
+
+	spans := recorder.Ended()
+	s.Require().Len(spans, 1)
+	s.ElementsMatch([]attribute.KeyValue{
+		attribute.StringSlice("filter.fields", []string{"login", "email"}),
+		attribute.StringSlice("filter.operators", []string{":", ":"}),
+		attribute.StringSlice("filter.values", []string{"sampleUser", redacted}),
+		attribute.Int("filter.search_len", 4),
+		attribute.Int("filter.page", 2),
+		attribute.Int("filter.page_size", 10),
+		attribute.StringSlice("filter.order_by", []string{"email desc"}),
+	}, spans[0].Attributes())
+}
+
+// TestNoSpan is a test for filtering the requests without the span.
+func (s *TestSuite) TestNoSpan() {
+	var users []User
+	c := &gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?filter=login:sampleUser", nil)}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1$`).
+		WithArgs("sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(filter.FilterByQuery(c, filter.FILTER, WithSpanAttributes())).Find(&users).Error
+	s.NoError(err)
+}
+
+func TestRunSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
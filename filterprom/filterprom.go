@@ -0,0 +1,71 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package filterprom implements the filter metrics with Prometheus.
+package filterprom
+
+import (
+	filter "github.com/ActiveChooN/gin-gorm-filter"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics counts the applied filter conditions, and observes the search phrase words and the page sizes
+// per model, see filter.WithMetrics.
+type Metrics struct {
+	filters   *prometheus.CounterVec
+	searches  *prometheus.HistogramVec
+	pageSizes *prometheus.HistogramVec
+}
+
+var _ filter.Metrics = (*Metrics)(nil)
+
+// New creates the metrics registered in the registerer:
+//   - filter_conditions_total, the counter of the filter conditions by model, param and operator
+//   - filter_search_tokens, the histogram of the search phrase words by model
+//   - filter_page_size, the histogram of the page sizes by model
+//
+// Example:
+//
+//	metrics, err := filterprom.New(prometheus.DefaultRegisterer)
+//	db.Model(&User{}).Scopes(filter.FilterByQuery(c, filter.ALL, filter.WithMetrics(metrics))).Find(&users)
+func New(registerer prometheus.Registerer) (*Metrics, error) {
+	m := &Metrics{
+		filters: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "filter_conditions_total",
+			Help: "Number of the applied filter conditions.",
+		}, []string{"model", "param", "operator"}),
+		searches: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "filter_search_tokens",
+			Help:    "Number of the words of the applied search phrases.",
+			Buckets: []float64{1, 2, 3, 5, 8},
+		}, []string{"model"}),
+		pageSizes: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "filter_page_size",
+			Help:    "Page sizes of the paginated queries.",
+			Buckets: []float64{10, 20, 50, 100},
+		}, []string{"model"}),
+	}
+	for _, collector := range []prometheus.Collector{m.filters, m.searches, m.pageSizes} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// ObserveFilter counts the filter condition.
+func (m *Metrics) ObserveFilter(model, param, operator string) {
+	m.filters.WithLabelValues(model, param, operator).Inc()
+}
+
+// ObserveSearch observes the number of the search phrase words.
+func (m *Metrics) ObserveSearch(model string, tokens int) {
+	m.searches.WithLabelValues(model).Observe(float64(tokens))
+}
+
+// ObservePageSize observes the page size.
+func (m *Metrics) ObservePageSize(model string, size int) {
+	m.pageSizes.WithLabelValues(model).Observe(float64(size))
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filterprom
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMetrics is a test for recording the filter usage in the registry.
+func TestMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	m, err := New(registry)
+	require.NoError(t, err)
+
+	m.ObserveFilter("User", "login", ":")
+	m.ObserveFilter("User", "login", ":")
+	m.ObserveFilter("User", "id", ">=")
+	m.ObserveSearch("User", 2)
+	m.ObservePageSize("User", 20)
+
+	require.Equal(t, 2.0, testutil.ToFloat64(m.filters.WithLabelValues("User", "login", ":")))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.filters.WithLabelValues("User", "id", ">=")))
+	require.Equal(t, 1, testutil.CollectAndCount(m.searches))
+	require.Equal(t, 1, testutil.CollectAndCount(m.pageSizes))
+
+	// The collectors are already registered.
+	_, err = New(registry)
+	require.Error(t, err)
+}
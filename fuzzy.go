@@ -0,0 +1,75 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm/clause"
+)
+
+// fuzzyOperator is the operator of the values within the edit distance, e.g. last_name~?smth:2.
+const fuzzyOperator = "~?"
+
+const (
+	defaultFuzzyDistance    = 1
+	defaultMaxFuzzyDistance = 3
+)
+
+// fuzzyExpression builds the condition of the values within the Levenshtein distance of the term, case-insensitive.
+// The value is the term, optionally followed by the distance after the last colon, 1 by default. The longer
+// distances are limited to the maximum or rejected in the strict mode, since every row is compared.
+func fuzzyExpression(column interface{}, value string, o *options) (clause.Expression, error) {
+	term, distance := value, defaultFuzzyDistance
+	if i := strings.LastIndexByte(value, ':'); i >= 0 {
+		if parsed, err := strconv.Atoi(value[i+1:]); err == nil {
+			term, distance = value[:i], parsed
+		}
+	}
+	switch {
+	case term == "":
+		return nil, errors.New("fuzzy term must not be empty")
+	case distance < 0:
+		return nil, errors.New("distance must not be negative")
+	case distance > o.maxFuzzyDistance && o.strict:
+		return nil, fmt.Errorf("distance must be at most %d", o.maxFuzzyDistance)
+	case distance > o.maxFuzzyDistance:
+		distance = o.maxFuzzyDistance
+	}
+	return clause.Expr{SQL: "levenshtein(LOWER(?), LOWER(?)) <= ?", Vars: []interface{}{column, term, distance}}, nil
+}
+
+// fuzzyDenied reports whether the terms match the filterable field with the fuzzy operator it isn't tagged for,
+// which is rejected in the strict mode rather than ignored.
+func fuzzyDenied(field fieldMeta, terms []filterTerm) bool {
+	if !field.Filterable {
+		return false
+	}
+	for _, term := range terms {
+		if term.param == field.param && term.operator == fuzzyOperator && !operatorApplies(field, term.operator) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkFuzzy rejects the fuzzy conditions for the dialects other than postgres, since levenshtein()
+// comes from its fuzzystrmatch extension.
+func checkFuzzy(dialect string, query Query) error {
+	if dialect == "postgres" {
+		return nil
+	}
+	for _, condition := range query.Filters {
+		if condition.Operator == fuzzyOperator {
+			return &Error{Param: "filter", Value: condition.Param + condition.Operator + condition.Value,
+				Reason: "fuzzy matching is only supported by postgres"}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"database/sql/driver"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type Patient struct {
+	Id       uint
+	LastName string `filter:"filterable;fuzzy"`
+	Age      int    `filter:"filterable;fuzzy"`
+	City     string `filter:"filterable"`
+}
+
+// TestFuzzyOperator is a test for filtering the fuzzy fields by the Levenshtein distance.
+func (s *TestSuite) TestFuzzyOperator() {
+	tests := []struct {
+		filter   string
+		opts     []Option
+		expected string
+		args     []driver.Value
+	}{
+		{"last_name~?smth:2", nil, `WHERE levenshtein\(LOWER\("patients"."last_name"\), LOWER\(\$1\)\) <= \$2`, []driver.Value{"smth", 2}},
+		{"last_name~?smth", nil, `WHERE levenshtein\(LOWER\("patients"."last_name"\), LOWER\(\$1\)\) <= \$2`, []driver.Value{"smth", 1}},
+		{"last_name~?smth:9", nil, `WHERE levenshtein\(LOWER\("patients"."last_name"\), LOWER\(\$1\)\) <= \$2`, []driver.Value{"smth", 3}},
+		{"last_name~?smth:9", []Option{WithMaxFuzzyDistance(1)}, `WHERE levenshtein\(LOWER\("patients"."last_name"\), LOWER\(\$1\)\) <= \$2`, []driver.Value{"smth", 1}},
+		// The fields which aren't tagged as fuzzy or aren't the strings are ignored.
+		{"city~?Lodnon", nil, ``, nil},
+		{"age~?42", nil, ``, nil},
+	}
+	for _, test := range tests {
+		var patients []Patient
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/patients?filter="+test.filter, nil)}
+		expected := `^SELECT \* FROM "patients"$`
+		if test.expected != "" {
+			expected = `^SELECT \* FROM "patients" ` + test.expected + `$`
+		}
+		query := s.mock.ExpectQuery(expected)
+		if test.args != nil {
+			query.WithArgs(test.args...)
+		}
+		query.WillReturnRows(sqlmock.NewRows([]string{"id", "last_name", "age", "city"}))
+		err := s.db.Model(&Patient{}).Scopes(FilterByQuery(&ctx, FILTER, test.opts...)).Find(&patients).Error
+		s.NoError(err, test.filter)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/patients?filter=last_name~?smth:9", nil)
+	err := s.db.Session(&gorm.Session{DryRun: true}).Model(&Patient{}).
+		Scopes(FilterByQuery(ctx, FILTER, WithStrict())).Find(&[]Patient{}).Error
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal("distance must be at most 3", filterErr.Reason)
+
+	// The fields which aren't tagged as fuzzy are rejected in the strict mode.
+	for _, filter := range []string{"city~?Lodnon", "last_name~?smth,city~?Lodnon"} {
+		_, err = ParseQuery(map[string][]string{"filter": {filter}}, &Patient{}, FILTER, WithStrict())
+		s.Equal(&Error{Param: "filter", Value: filter, Reason: "operator not allowed"}, err, filter)
+	}
+
+	s.EqualError(Validate(&Patient{}), "filter: invalid field Patient.Age: fuzzy field must be a string")
+}
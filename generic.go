@@ -0,0 +1,70 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// Scope works like FilterByQuery, setting the model of type T for the query if it's not set yet.
+// An explicitly set model is respected.
+// Example:
+//
+//	db.Scopes(filter.Scope[User](c, filter.ALL)).Find(&users)
+func Scope[T any](c *gin.Context, config int, opts ...Option) func(db *gorm.DB) *gorm.DB {
+	return withModel[T](FilterByQuery(c, config, opts...))
+}
+
+// withModel wraps the scope, setting the model of type T for the query if it's not set yet.
+func withModel[T any](scope func(db *gorm.DB) *gorm.DB) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if db.Statement.Model == nil {
+			db = db.Model(new(T))
+		}
+		return scope(db)
+	}
+}
+
+// Find queries the models of type T filtered with the query parameters.
+// Example:
+//
+//	users, err := filter.Find[User](c, db, filter.ALL)
+func Find[T any](c *gin.Context, db *gorm.DB, config int, opts ...Option) ([]T, error) {
+	var result []T
+	err := db.Scopes(Scope[T](c, config, opts...)).Find(&result).Error
+	return result, err
+}
+
+// FindPage works like Find, additionally returning the meta of the applied query parameters. Counting is
+// expensive, so the models matching the search and the filters are only counted if the client asks for it
+// with with_count=true and PAGINATE is set, the total of the meta is nil otherwise.
+// Example:
+//
+//	users, meta, err := filter.FindPage[User](c, db, filter.ALL)
+//	c.JSON(http.StatusOK, gin.H{"data": users, "meta": meta})
+func FindPage[T any](c *gin.Context, db *gorm.DB, config int, opts ...Option) ([]T, Meta, error) {
+	var (
+		result []T
+		meta   Meta
+	)
+	o := newOptions(opts)
+	scope := withModel[T](filterByQuery(c, ConfigFromBits(config), o, &meta))
+	if err := db.Scopes(scope).Find(&result).Error; err != nil || !meta.WithCount {
+		return result, meta, err
+	}
+
+	// The request was already audited, logged and observed with the list.
+	countOptions := *o
+	countOptions.auditHook, countOptions.debugLogger, countOptions.metrics = nil, nil, nil
+	var total int64
+	scope = withModel[T](filterByQuery(c, ConfigFromBits(config&(SEARCH|FILTER)), &countOptions, nil))
+	if err := db.Scopes(scope).Count(&total).Error; err != nil {
+		return result, meta, err
+	}
+	meta.Total = &total
+	return result, meta, nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestScopeSetsModel is a test for the generic scope setting the model automatically.
+func (s *TestSuite) TestScopeSetsModel() {
+	var users []User
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=login:sampleUser",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1$`).
+		WithArgs("sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Scopes(Scope[User](&ctx, FILTER)).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestScopeExplicitModel is a test for the generic scope respecting the explicitly set model.
+func (s *TestSuite) TestScopeExplicitModel() {
+	var organizations []Organization
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=id:1",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "organizations" WHERE "organizations"."id" = \$1$`).
+		WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	err := s.db.Model(&Organization{}).Scopes(Scope[User](&ctx, FILTER)).Find(&organizations).Error
+	s.NoError(err)
+}
+
+// TestFind is a test for the generic find helper.
+func (s *TestSuite) TestFind() {
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "search=John",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\)$`).
+		WithArgs("%john%", "%john%").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}).AddRow(1, "john", "John Doe", "john@example.com", ""))
+	users, err := Find[User](&ctx, s.db, SEARCH)
+	s.NoError(err)
+	s.Len(users, 1)
+	s.Equal("john", users[0].Username)
+}
+
+// TestFindPage is a test for counting the models only if the client asks for it.
+func (s *TestSuite) TestFindPage() {
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=login:sampleUser&page=2",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1 LIMIT \$2 OFFSET \$3$`).
+		WithArgs("sampleUser", 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	_, meta, err := FindPage[User](&ctx, s.db, FILTER|PAGINATE)
+	s.NoError(err)
+	s.False(meta.WithCount)
+	s.Nil(meta.Total)
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	ctx.Request.URL.RawQuery += "&with_count=true"
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1 LIMIT \$2 OFFSET \$3$`).
+		WithArgs("sampleUser", 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	s.mock.ExpectQuery(`^SELECT count\(\*\) FROM "users" WHERE "users"."username" = \$1$`).
+		WithArgs("sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+	_, meta, err = FindPage[User](&ctx, s.db, FILTER|PAGINATE)
+	s.NoError(err)
+	s.True(meta.WithCount)
+	s.Require().NotNil(meta.Total)
+	s.Equal(int64(12), *meta.Total)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
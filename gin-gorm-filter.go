@@ -6,9 +6,9 @@
 package filter
 
 import (
-	"fmt"
-	"reflect"
-	"regexp"
+	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -19,13 +19,20 @@ import (
 )
 
 type queryParams struct {
-	Search         string   `form:"search"`
-	Filter         []string `form:"filter"`
-	Page           int      `form:"page,default=1"`
-	PageSize       int      `form:"page_size,default=10"`
-	All            bool     `form:"all,default=false"`
-	OrderBy        string   `form:"order_by,default=id"`
-	OrderDirection string   `form:"order_direction,default=desc,oneof=desc asc"`
+	Search         string   // search
+	Filter         []string // filter
+	Presets        []string // preset
+	Page           int      // page, 1 by default
+	PageSize       int      // page_size, 10 by default
+	All            bool     // all, false by default
+	OrderBy        []string // order_by, repeated or comma separated, id by default
+	OrderDirection string   // order_direction, desc by default
+	Fields         []string // fields, comma separated
+	ExcludeFields  []string // exclude_fields, comma separated
+	GroupBy        string   // group_by
+	// JSON:API syntax
+	Sort      []Order             // sort, overrides order_by and order_direction
+	Fieldsets map[string][]string // fields[type]
 }
 
 const (
@@ -37,126 +44,469 @@ const (
 	tagKey   = "filter"
 )
 
-var (
-	paramNameRegexp = regexp.MustCompile(`(?m)param:(\w{1,}).*`)
-)
+func orderBy(db *gorm.DB, query Query, o *options) *gorm.DB {
+	return db.Order(clause.OrderBy{Columns: orderColumns(orderMeta(db, query, o), query)})
+}
 
-func orderBy(db *gorm.DB, params queryParams) *gorm.DB {
-	return db.Order(clause.OrderByColumn{
-		Column: clause.Column{Name: params.OrderBy},
-		Desc:   params.OrderDirection == "desc"},
-	)
+// orderColumns returns the columns the query orders by, the order one and then the secondary ones.
+func orderColumns(meta *modelMeta, query Query) []clause.OrderByColumn {
+	columns := make([]clause.OrderByColumn, 0, 1+len(query.ThenBy))
+	columns = append(columns, clause.OrderByColumn{Column: orderColumn(meta, query.OrderBy), Desc: query.OrderDesc})
+	for _, order := range query.ThenBy {
+		columns = append(columns, clause.OrderByColumn{Column: orderColumn(meta, order.Column), Desc: order.Desc})
+	}
+	return columns
+}
+
+// orderMeta returns the metadata of the query if the client ordered the rows explicitly, so the params are resolved
+// to the columns, or if the model has the expression fields, nil otherwise, so the schema isn't parsed just to order
+// by the default columns.
+func orderMeta(db *gorm.DB, query Query, o *options) *modelMeta {
+	if query.explicitOrder {
+		_, meta, _ := queryMeta(db, o)
+		return meta
+	}
+	if modelType := structType(statementModel(db, o)); modelType != nil && !expressesFields(modelType) {
+		return nil
+	} else if modelType == nil && !slices.ContainsFunc(o.tableFields, func(field Field) bool { return field.Expr != "" }) {
+		return nil
+	}
+	_, meta, _ := queryMeta(db, o)
+	return meta
+}
+
+// hasDefaults reports whether the queried model has the default filter values, so the model is only
+// introspected for them if it does.
+func hasDefaults(db *gorm.DB, o *options) bool {
+	if modelType := structType(statementModel(db, o)); modelType != nil {
+		return defaultsFields(modelType)
+	}
+	return slices.ContainsFunc(o.tableFields, hasDefault)
+}
+
+// orderColumn returns the column ordered by the param, the column or the expression of the sortable field with the param
+// or the column, the param itself otherwise.
+func orderColumn(meta *modelMeta, param string) clause.Column {
+	if field, ok := sortableField(meta, param); ok {
+		if field.Expr != "" {
+			return clause.Column{Name: field.Expr, Raw: true}
+		}
+		return clause.Column{Name: field.Column}
+	}
+	return clause.Column{Name: param}
+}
+
+// sortableField looks up the sortable field by the param or the column.
+func sortableField(meta *modelMeta, name string) (fieldMeta, bool) {
+	if meta != nil {
+		for _, field := range meta.fields {
+			if field.Sortable && (field.param == name || field.Column == name) {
+				return field, true
+			}
+		}
+	}
+	return fieldMeta{}, false
 }
 
-func paginate(db *gorm.DB, params queryParams) *gorm.DB {
-	if params.All {
+// foldOrder replaces the order columns matching the params or the columns of the sortable fields only by case with
+// the columns of the fields, and the ones matching the other columns of the model only by case with the columns.
+func foldOrder(meta *modelMeta, query *Query) {
+	fold := func(name string) string {
+		if _, ok := sortableField(meta, name); ok || slices.Contains(meta.columns, name) {
+			return name
+		}
+		for _, field := range meta.fields {
+			if field.Sortable && (strings.EqualFold(field.param, name) || strings.EqualFold(field.Column, name)) {
+				return field.Column
+			}
+		}
+		if index := slices.IndexFunc(meta.columns, func(column string) bool { return strings.EqualFold(column, name) }); index >= 0 {
+			return meta.columns[index]
+		}
+		return name
+	}
+	query.OrderBy = fold(query.OrderBy)
+	if len(query.ThenBy) > 0 {
+		query.ThenBy = slices.Clone(query.ThenBy)
+		for i := range query.ThenBy {
+			query.ThenBy[i].Column = fold(query.ThenBy[i].Column)
+		}
+	}
+}
+
+// fallbackOrder replaces the order column the client requested which isn't the param or the column of a sortable field
+// with the fallback, the first of the fallback columns the model has or the primary key, and drops the invalid secondary
+// orders. In the strict mode the invalid columns are rejected.
+func fallbackOrder(meta *modelMeta, query *Query, o *options) error {
+	if !query.explicitOrder || meta == nil {
+		return nil
+	}
+	sortable := func(name string) bool {
+		_, ok := sortableField(meta, name)
+		return ok
+	}
+	orders := append([]Order{{Column: query.OrderBy}}, query.ThenBy...)
+	for _, order := range orders {
+		if o.strict && !sortable(order.Column) {
+			return &Error{Param: "order_by", Value: order.Column, Reason: "not sortable"}
+		}
+	}
+	query.ThenBy = slices.DeleteFunc(slices.Clone(query.ThenBy), func(order Order) bool { return !sortable(order.Column) })
+	if sortable(query.OrderBy) {
+		return nil
+	}
+	for _, column := range append(slices.Clone(o.orderFallback), meta.primaryKeys...) {
+		if sortable(column) || slices.Contains(meta.columns, column) {
+			query.OrderBy, query.orderFallback = column, true
+			return nil
+		}
+	}
+	return nil
+}
+
+func paginate(db *gorm.DB, query Query, o *options) *gorm.DB {
+	if query.All {
 		return db
 	}
+	// SQL Server only pages the ordered rows with OFFSET ... FETCH, the primary key keeps the pages stable.
+	if db.Dialector.Name() == "sqlserver" {
+		db = orderByPrimaryKey(db, o)
+	}
 
+	offset := (query.Page - 1) * query.PageSize
+	return db.Offset(offset).Limit(query.PageSize)
+}
+
+// orderByPrimaryKey orders the statement by the primary key unless it's already ordered or the primary key
+// is unknown, e.g. for the table fields.
+func orderByPrimaryKey(db *gorm.DB, o *options) *gorm.DB {
+	if _, ok := db.Statement.Clauses["ORDER BY"]; ok {
+		return db
+	}
+	table, meta, ok := queryMeta(db, o)
+	if !ok || len(meta.primaryKeys) == 0 {
+		return db
+	}
+	columns := make([]clause.OrderByColumn, 0, len(meta.primaryKeys))
+	for _, primaryKey := range meta.primaryKeys {
+		columns = append(columns, clause.OrderByColumn{Column: clause.Column{Table: table, Name: primaryKey}})
+	}
+	return db.Order(clause.OrderBy{Columns: columns})
+}
+
+// dryRun keeps the statement matching no rows from being sent to the database. The config of the statement
+// is replaced rather than a dry run session started, so it also applies to the statement of the Plugin callback,
+// whose scope result is discarded.
+func dryRun(db *gorm.DB) *gorm.DB {
+	config := *db.Config
+	config.DryRun = true
+	db.Config = &config
+	return db
+}
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+// normalizePagination sets the page and the page size to the valid values.
+func normalizePagination(params *queryParams) {
 	if params.Page == 0 {
 		params.Page = 1
 	}
 
 	switch {
-	case params.PageSize > 100:
-		params.PageSize = 100
+	case params.PageSize > maxPageSize:
+		params.PageSize = maxPageSize
 	case params.PageSize <= 0:
-		params.PageSize = 10
+		params.PageSize = defaultPageSize
 	}
-
-	offset := (params.Page - 1) * params.PageSize
-	return db.Offset(offset).Limit(params.PageSize)
 }
 
-func searchField(columnName string, field reflect.StructField, phrase string) clause.Expression {
-	filterTag := field.Tag.Get(tagKey)
+// searchExpression builds the search expression of the searchable fields. The patterns are built
+// once for all the fields.
+func (o *options) searchExpression(meta *modelMeta, phrase string) clause.Expression {
+	expressions := o.likeExpressions(meta.fields, phrase, "%")
+	ids := o.idExpressions(meta, phrase)
+	if o.searchCombinator == And {
+		if expression := joinGroup(expressions, clause.And, o.hook(KindSearch)); expression != nil {
+			// The ID matches on its own, rather than along with all the fields.
+			ids = append([]clause.Expression{expression}, ids...)
+		}
+		return joinExpressions(ids, clause.Or)
+	}
+	return joinGroup(append(expressions, ids...), clause.Or, o.hook(KindSearch))
+}
 
-	if strings.Contains(filterTag, "searchable") {
-		return clause.Like{
-			Column: clause.Expr{SQL: "LOWER(?)", Vars: []interface{}{clause.Column{Table: clause.CurrentTable, Name: columnName}}},
-			Value:  "%" + strings.ToLower(phrase) + "%",
+// likeExpressions builds the LIKE expressions of the searchable fields matching the phrase after the prefix.
+func (o *options) likeExpressions(fields []fieldMeta, phrase, prefix string) []clause.Expression {
+	var (
+		plain, lower       likePattern
+		hasPlain, hasLower bool
+	)
+	expressions := make([]clause.Expression, 0, len(fields))
+	for i := range fields {
+		field := &fields[i]
+		if !field.Searchable || field.SearchID {
+			continue
+		}
+		if field.SearchCase == CaseSensitive || (field.SearchCase == CaseDefault && o.plainSearch) {
+			if !hasPlain {
+				plain, hasPlain = o.likePattern(phrase, prefix, "%"), true
+			}
+			expressions = append(expressions, o.likeExpression(field.column, plain))
+			continue
 		}
+		if !hasLower {
+			lower, hasLower = o.likePattern(strings.ToLower(phrase), prefix, "%"), true
+		}
+		expressions = append(expressions, o.searchLikeExpression(field, lower))
 	}
-	return nil
+	return expressions
 }
 
-func filterField(columnName string, field reflect.StructField, phrase string) clause.Expression {
-	var paramName string
-	if !strings.Contains(field.Tag.Get(tagKey), "filterable") {
+// relevanceExpression builds the relevance of the rows to the search phrase, 0 if any searchable field
+// starts with the phrase, 1 if it contains the phrase, 2 otherwise. It's nil without the searchable fields.
+func (o *options) relevanceExpression(fields []fieldMeta, phrase string) clause.Expression {
+	prefixes := o.likeExpressions(fields, phrase, "")
+	if len(prefixes) == 0 {
 		return nil
 	}
-	paramMatch := paramNameRegexp.FindStringSubmatch(field.Tag.Get(tagKey))
-	if len(paramMatch) == 2 {
-		paramName = paramMatch[1]
-	} else {
-		paramName = columnName
+	return clause.Expr{
+		SQL:  "CASE WHEN ? THEN 0 WHEN ? THEN 1 ELSE 2 END",
+		Vars: []interface{}{clause.Or(prefixes...), clause.Or(o.likeExpressions(fields, phrase, "%")...)},
 	}
+}
 
-	// re, err := regexp.Compile(fmt.Sprintf(`(?m)%v([:<>!=]{1,2})(\w{1,}).*`, paramName))
-	// for the current regex, the compound operators (such as >=) must come before the
-	// single operators (such as <) or they will be incorrectly identified
-	re, err := regexp.Compile(fmt.Sprintf(`(?m)%v(:|!=|>=|<=|>|<|~)([^,]*).*`, paramName))
+// relevanceOrder orders the rows by the relevance to the search phrase after the orders of the statement,
+// then by the default order as the tiebreaker.
+func relevanceOrder(db *gorm.DB, relevance clause.Expression, query Query, o *options) *gorm.DB {
+	meta := orderMeta(db, query, o)
+	orders := make([]clause.Expression, 0, 3)
+	if orderBy, ok := db.Statement.Clauses["ORDER BY"].Expression.(clause.OrderBy); ok {
+		orders = append(orders, orderBy)
+	}
+	orders = append(orders, relevance, clause.OrderBy{
+		Columns: []clause.OrderByColumn{{Column: orderColumn(meta, query.OrderBy), Desc: query.OrderDesc}},
+	})
+	db.Statement.AddClause(clause.OrderBy{Expression: clause.CommaExpression{Exprs: orders}})
+	return db
+}
+
+// idExpressions builds the equality expressions of the integer phrase for the fields tagged as `searchable:id`,
+// and for the primary key with WithIDSearch.
+func (o *options) idExpressions(meta *modelMeta, phrase string) []clause.Expression {
+	id, err := strconv.ParseInt(phrase, 10, 64)
 	if err != nil {
 		return nil
 	}
-	filterSubPhraseMatch := re.FindStringSubmatch(phrase)
-	if len(filterSubPhraseMatch) == 3 {
-		switch filterSubPhraseMatch[1] {
-		case ">=":
-			return clause.Gte{Column: clause.Column{Table: clause.CurrentTable, Name: columnName}, Value: filterSubPhraseMatch[2]}
-		case "<=":
-			return clause.Lte{Column: clause.Column{Table: clause.CurrentTable, Name: columnName}, Value: filterSubPhraseMatch[2]}
-		case "!=":
-			return clause.Neq{Column: clause.Column{Table: clause.CurrentTable, Name: columnName}, Value: filterSubPhraseMatch[2]}
-		case ">":
-			return clause.Gt{Column: clause.Column{Table: clause.CurrentTable, Name: columnName}, Value: filterSubPhraseMatch[2]}
-		case "<":
-			return clause.Lt{Column: clause.Column{Table: clause.CurrentTable, Name: columnName}, Value: filterSubPhraseMatch[2]}
-		case "~":
-			return clause.Like{Column: clause.Column{Table: clause.CurrentTable, Name: columnName}, Value: filterSubPhraseMatch[2]}
-		default:
-			return clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: columnName}, Value: filterSubPhraseMatch[2]}
+	var expressions []clause.Expression
+	for _, field := range meta.fields {
+		if field.Searchable && field.SearchID {
+			expressions = append(expressions, clause.Eq{Column: field.column, Value: id})
 		}
 	}
-	return nil
+	if o.idSearch && len(expressions) == 0 {
+		for _, primaryKey := range meta.primaryKeys {
+			expressions = append(expressions, clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: primaryKey}, Value: id})
+		}
+	}
+	return expressions
 }
 
-func expressionByField(
-	db *gorm.DB, phrases []string,
-	operator func(string, reflect.StructField, string) clause.Expression,
-	predicate func(...clause.Expression) clause.Expression,
-) *gorm.DB {
-	modelType := reflect.TypeOf(db.Statement.Model).Elem()
-	numFields := modelType.NumField()
-	modelSchema, err := schema.Parse(db.Statement.Model, &sync.Map{}, db.NamingStrategy)
-	if err != nil {
-		return db
+// filterParam returns the query param name of the field.
+func filterParam(field Field) string {
+	if field.Param != "" {
+		return field.Param
 	}
-	var allExpressions []clause.Expression
+	return field.Column
+}
 
-	for _, phrase := range phrases {
-		expressions := make([]clause.Expression, 0, numFields)
-		for i := 0; i < numFields; i++ {
-			field := modelType.Field(i)
-			expression := operator(modelSchema.LookUpField(field.Name).DBName, field, phrase)
-			if expression != nil {
-				expressions = append(expressions, expression)
-			}
+func (o *options) filterExpression(column interface{}, operator, value string) clause.Expression {
+	if operator != "~" {
+		return comparisonExpression(column, operator, value)
+	}
+	return o.likeExpression(column, o.filterLikePattern(value, false))
+}
+
+// filterLikePattern returns the pattern of the `~` filter value, passed verbatim with WithRawLike
+// or for the raw fields.
+func (o *options) filterLikePattern(value string, raw bool) likePattern {
+	if o.rawLike || raw {
+		return likePattern{value: value}
+	}
+	return o.likePattern(value, "", "")
+}
+
+// comparisonExpression builds the expression of the comparison operator, the equality by default.
+func comparisonExpression(column interface{}, operator string, value interface{}) clause.Expression {
+	switch operator {
+	case ">=":
+		return clause.Gte{Column: column, Value: value}
+	case "<=":
+		return clause.Lte{Column: column, Value: value}
+	case "!=":
+		return clause.Neq{Column: column, Value: value}
+	case ">":
+		return clause.Gt{Column: column, Value: value}
+	case "<":
+		return clause.Lt{Column: column, Value: value}
+	default:
+		return clause.Eq{Column: column, Value: value}
+	}
+}
+
+// queryMeta returns the table and the fields metadata of the query, with the column names resolved
+// from the statement schema. It's not ok if the query can't be searched or filtered.
+func queryMeta(db *gorm.DB, o *options) (string, *modelMeta, bool) {
+	model := statementModel(db, o)
+	table, meta, ok := modelQueryMeta(model, db.NamingStrategy, o, func() (*schema.Schema, error) {
+		err := db.Statement.Parse(model)
+		return db.Statement.Schema, err
+	})
+	if ok && o.fieldPolicy != nil {
+		meta = o.policyMeta(meta)
+	}
+	return table, meta, ok
+}
+
+// statementModel returns the model of the statement, falling back to the destination unless the table
+// fields are configured, since gorm only does it after the scopes are applied.
+func statementModel(db *gorm.DB, o *options) interface{} {
+	if db.Statement.Model == nil && o.table == "" {
+		return db.Statement.Dest
+	}
+	return db.Statement.Model
+}
+
+// modelQueryMeta returns the table and the fields metadata of the model, parsing the schema with
+// the parse function on the first use. It falls back to the table fields from the options if the model is nil.
+func modelQueryMeta(model interface{}, namer schema.Namer, o *options, parse func() (*schema.Schema, error)) (string, *modelMeta, bool) {
+	if modelType := structType(model); modelType != nil {
+		meta, ok := cachedModelMeta(modelType, namer, parse)
+		if !ok {
+			return "", nil, false
+		}
+		return clause.CurrentTable, meta, true
+	}
+
+	if o.table != "" {
+		return o.table, tableMeta(o.table, o.tableFields, namer), true
+	}
+	return "", nil, false
+}
+
+// defaultQueryMeta returns the table and the fields metadata of the model without the DB,
+// using the default naming strategy.
+func defaultQueryMeta(model interface{}, o *options) (string, *modelMeta, bool) {
+	namer := schema.NamingStrategy{}
+	return modelQueryMeta(model, namer, o, func() (*schema.Schema, error) {
+		return schema.Parse(model, &sync.Map{}, namer)
+	})
+}
+
+// conditionExpression builds the expression of the resolved condition on the boxed column.
+func (o *options) conditionExpression(condition Condition, column interface{}) clause.Expression {
+	if condition.expression != nil {
+		return condition.expression
+	}
+	return o.filterExpression(column, condition.Operator, condition.Value)
+}
+
+// filterExpressions builds the expressions of the filter conditions, grouped by the filter params and
+// joined with the predicate. The columns are the boxed columns of the conditions.
+func (o *options) filterExpressions(conditions []Condition, columns []interface{}, predicate func(...clause.Expression) clause.Expression) clause.Expression {
+	hook := o.hook(KindFilter)
+	allExpressions := make([]clause.Expression, 0, len(conditions))
+
+	for start := 0; start < len(conditions); {
+		end := start + 1
+		for end < len(conditions) && conditions[end].phrase == conditions[start].phrase {
+			end++
+		}
+		if end-start == 1 && hook == nil {
+			allExpressions = append(allExpressions, o.conditionExpression(conditions[start], columns[start]))
+			start = end
+			continue
 		}
-		if len(expressions) > 0 {
-			allExpressions = append(allExpressions, predicate(expressions...))
+		expressions := make([]clause.Expression, 0, end-start)
+		for i, condition := range conditions[start:end] {
+			expressions = append(expressions, o.conditionExpression(condition, columns[start+i]))
 		}
+		if expression := joinGroup(expressions, clause.And, hook); expression != nil {
+			allExpressions = append(allExpressions, expression)
+		}
+		start = end
 	}
-	if len(allExpressions) == 1 {
-		db = db.Where(allExpressions[0])
-	} else if len(allExpressions) > 1 {
-		db = db.Where(predicate(allExpressions...))
+	return joinExpressions(allExpressions, predicate)
+}
+
+// splitHaving splits the conditions of the having fields and their columns off the WHERE ones.
+func splitHaving(conditions []Condition, columns []interface{}) ([]Condition, []interface{}, []Condition, []interface{}) {
+	if !slices.ContainsFunc(conditions, func(condition Condition) bool { return condition.having }) {
+		return conditions, columns, nil, nil
+	}
+	var (
+		where, having               []Condition
+		whereColumns, havingColumns []interface{}
+	)
+	for i, condition := range conditions {
+		if condition.having {
+			having, havingColumns = append(having, condition), append(havingColumns, columns[i])
+		} else {
+			where, whereColumns = append(where, condition), append(whereColumns, columns[i])
+		}
+	}
+	return where, whereColumns, having, havingColumns
+}
+
+// splitDefaults splits the conditions of the default values and their columns off the client's ones.
+func splitDefaults(conditions []Condition, columns []interface{}) ([]Condition, []interface{}, []Condition, []interface{}) {
+	if !slices.ContainsFunc(conditions, func(condition Condition) bool { return condition.defaulted }) {
+		return conditions, columns, nil, nil
+	}
+	var (
+		filters, defaults             []Condition
+		filterColumns, defaultColumns []interface{}
+	)
+	for i, condition := range conditions {
+		if condition.defaulted {
+			defaults, defaultColumns = append(defaults, condition), append(defaultColumns, columns[i])
+		} else {
+			filters, filterColumns = append(filters, condition), append(filterColumns, columns[i])
+		}
+	}
+	return filters, filterColumns, defaults, defaultColumns
+}
+
+// joinGroup applies the hook to the group of expressions and joins it with the predicate.
+func joinGroup(
+	expressions []clause.Expression,
+	predicate func(...clause.Expression) clause.Expression,
+	hook func([]clause.Expression) []clause.Expression,
+) clause.Expression {
+	if hook != nil && len(expressions) > 0 {
+		expressions = hook(expressions)
+	}
+	return joinExpressions(expressions, predicate)
+}
+
+// joinExpressions joins the expressions with the predicate. A single expression isn't wrapped,
+// since gorm joins single OR conditions with OR.
+func joinExpressions(expressions []clause.Expression, predicate func(...clause.Expression) clause.Expression) clause.Expression {
+	switch len(expressions) {
+	case 0:
+		return nil
+	case 1:
+		return expressions[0]
+	default:
+		return predicate(expressions...)
 	}
-	return db
 }
 
 // Filter DB request with query parameters.
-// Note: Don't forget to initialize DB Model first, otherwise filter and search won't work
+// Note: Don't forget to initialize DB Model first, otherwise filter and search won't work.
+// For db.Table queries without a model the fields could be configured with filter.WithTable.
 // Example:
 //
 //	db.Model(&UserModel).Scope(filter.FilterByQuery(ctx, filter.ALL)).Find(&users)
@@ -173,31 +523,174 @@ func expressionByField(
 //		// `param` defines custom column name for the query param
 //		FullName string `filter:"searchable"`
 //	}
-func FilterByQuery(c *gin.Context, config int) func(db *gorm.DB) *gorm.DB {
+//
+// Options could be passed to customize the behavior, e.g. filter.WithForcedConditions.
+func FilterByQuery(c *gin.Context, config int, opts ...Option) func(db *gorm.DB) *gorm.DB {
+	return filterByQuery(c, ConfigFromBits(config), newOptions(opts), nil)
+}
+
+// FilterByQueryWithModel works like FilterByQuery with the model of the fields supplied explicitly, e.g. for
+// the queries with Table, and sets it on the statement unless it has one.
+// Example:
+//
+//	db.Table("users").Scopes(filter.FilterByQueryWithModel(c, filter.ALL, &User{})).Find(&rows)
+func FilterByQueryWithModel(c *gin.Context, config int, model interface{}, opts ...Option) func(db *gorm.DB) *gorm.DB {
+	scope := FilterByQuery(c, config, opts...)
 	return func(db *gorm.DB) *gorm.DB {
-		var params queryParams
-		err := c.BindQuery(&params)
-		if err != nil {
-			return db
+		if db.Statement.Model == nil {
+			db = db.Model(model)
 		}
+		return scope(db)
+	}
+}
+
+// filterByQuery builds the scope, describing the applied query parameters in the meta if it's not nil.
+func filterByQuery(c *gin.Context, config Config, o *options, meta *Meta) func(db *gorm.DB) *gorm.DB {
+	return filterByValues(requestValues(c.Request, o), c, config, o, meta)
+}
 
-		model := db.Statement.Model
-		modelType := reflect.TypeOf(model)
-		if model != nil && modelType.Kind() == reflect.Ptr && modelType.Elem().Kind() == reflect.Struct {
-			if config&SEARCH > 0 && params.Search != "" {
-				db = expressionByField(db, []string{params.Search}, searchField, clause.Or)
+// applyQuery applies the parsed query to the DB request. The gin context is nil for the other adapters.
+func applyQuery(db *gorm.DB, c *gin.Context, query Query, config Config, o *options, meta *Meta) (*gorm.DB, error) {
+	o = o.forRequest(c)
+	if o.safeWrite {
+		// Order, limit, selection and unscoping must never leak into the write statements.
+		config = Config{Search: config.Search, Filter: config.Filter}
+		query.Fields, query.ExcludeFields, query.Fieldsets = nil, nil, nil
+		query.IncludeDeleted, query.OnlyDeleted = false, false
+	}
+	if query.All && o.allowAll != nil && !o.allowAll(c) {
+		if o.strict {
+			return db, &Error{Param: "all", Value: "true", Reason: "not allowed", Status: http.StatusForbidden}
+		}
+		query.All = false
+	}
+	var built builtQuery
+	if !o.skipConditions {
+		var (
+			table string
+			model *modelMeta
+		)
+		// The model is only introspected if there is anything to search or filter.
+		if query.Search != "" || len(query.filter) > 0 || len(query.Presets) > 0 || len(query.params) > 0 ||
+			query.Cursor != nil || query.IDs != nil || query.defaults && hasDefaults(db, o) {
+			var ok bool
+			if table, model, ok = queryMeta(db, o); !ok && (query.Search != "" || len(query.filter) > 0 || len(query.Presets) > 0) {
+				if err := o.noModel(query); err != nil {
+					return db, err
+				}
 			}
-			if config&FILTER > 0 && len(params.Filter) > 0 {
-				db = expressionByField(db, params.Filter, filterField, clause.And)
+		}
+		var err error
+		if built, err = o.buildConditions(table, model, &query); err != nil {
+			return db, err
+		}
+		if err := checkFuzzy(db.Dialector.Name(), query); err != nil {
+			return db, err
+		}
+		conditions := built.where
+		if query.where != nil {
+			conditions = append(conditions, query.where)
+		}
+		if built.having != nil {
+			db = db.Having(built.having)
+		}
+		if query.IncludeDeleted || query.OnlyDeleted {
+			db = db.Unscoped()
+			if expression := deletedExpression(db, query, o); expression != nil {
+				conditions = append(conditions, expression)
 			}
 		}
+		if o.forcedConditions != nil {
+			conditions = append(conditions, o.forcedConditions(c)...)
+		}
+		if len(conditions) > 0 {
+			db = db.Where(clause.And(conditions...))
+		}
+		if o.safeWrite && len(query.Filters) == 0 {
+			return db, &Error{Param: "filter", Reason: "required for the write statements"}
+		}
+		if config.FilterRequired && !built.searched && len(query.Filters) == 0 {
+			return db, &Error{Param: "filter", Reason: "search or filter is required"}
+		}
+		if err := o.checkRequired(query); err != nil {
+			return db, err
+		}
+		if o.auditHook != nil {
+			o.auditHook(c, query.clone())
+		}
+		if query.matchesNothing {
+			db = dryRun(db)
+		}
+	}
+	if config.Paginate {
+		limitPageSize(db.Statement.Model, &query, o)
+	}
+	if o.metrics != nil {
+		observe(o.metrics, db, query, built.searched, config.Paginate, o)
+	}
+	var columns []string
+	if len(query.Fields) > 0 || len(query.ExcludeFields) > 0 || len(query.Fieldsets) > 0 {
+		var err error
+		if columns, err = selectColumns(db, query, o); err != nil {
+			return db, err
+		}
+	}
+	// The count statements select the count, so the columns are only counted distinct,
+	// and the write statements select the updated columns.
+	switch {
+	case config.Distinct:
+		db = distinct(db, columns, o)
+	case isCount(db) && (o.distinctCount || multipliesRows(db, o)):
+		db = distinct(db, nil, o)
+	case isCount(db) || o.safeWrite:
+	case len(columns) > 0:
+		db = db.Select(columns)
+	case len(db.Statement.Selects) == 0:
+		if columns := defaultColumns(db, o); len(columns) > 0 {
+			db = db.Select(columns)
+		}
+	}
+	if config.OrderBy && query.explicitOrder && !o.caseSensitive {
+		if _, model, ok := queryMeta(db, o); ok {
+			foldOrder(model, &query)
+		}
+	}
+	if config.OrderBy && o.fieldPolicy != nil && query.explicitOrder {
+		_, model, _ := queryMeta(db, o)
+		if err := denyOrder(model, &query, o); err != nil {
+			return db, err
+		}
+	}
+	if config.OrderBy && len(o.orderFallback) > 0 && query.explicitOrder {
+		_, model, _ := queryMeta(db, o)
+		if err := fallbackOrder(model, &query, o); err != nil {
+			return db, err
+		}
+	}
+	if meta != nil {
+		*meta = newMeta(query)
+	}
 
-		if config&ORDER_BY > 0 {
-			db = orderBy(db, params)
+	switch {
+	case query.Cursor != nil:
+		_, model, _ := queryMeta(db, o)
+		db = db.Order(clause.OrderBy{Columns: cursorOrderColumns(model, query.Cursor, o)})
+	case query.idsOrder && !isCount(db):
+		table, model, _ := queryMeta(db, o)
+		expression, err := idsOrderExpression(table, model, query.IDs)
+		if err != nil {
+			return db, err
 		}
-		if config&PAGINATE > 0 {
-			db = paginate(db, params)
+		if expression != nil {
+			db = db.Order(clause.OrderBy{Expression: expression})
 		}
-		return db
+	case config.OrderBy && built.relevance != nil && !isCount(db):
+		db = relevanceOrder(db, built.relevance, query, o)
+	case config.OrderBy:
+		db = orderBy(db, query, o)
+	}
+	if config.Paginate {
+		db = paginate(db, query, o)
 	}
+	return db, nil
 }
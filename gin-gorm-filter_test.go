@@ -20,8 +20,9 @@ import (
 )
 
 type Organization struct {
-	Id   uint   `filter:"param:id;filterable"`
-	Name string `filter:"param:name;searchable"`
+	Id    uint   `filter:"param:id;filterable"`
+	Name  string `filter:"param:name;searchable"`
+	Users []User `filter:"filterable"`
 }
 
 type User struct {
@@ -222,7 +223,7 @@ func (s *TestSuite) TestFiltersSearchable() {
 		},
 	}
 
-	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \(LOWER\("users"."username"\) LIKE \$1 OR LOWER\("users"."full_name"\) LIKE \$2\)$`).
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\)$`).
 		WithArgs("%john%", "%john%").
 		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
 	err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, SEARCH)).Find(&users).Error
@@ -256,12 +257,34 @@ func (s *TestSuite) TestFiltersOrderBy() {
 		},
 	}
 
-	s.mock.ExpectQuery(`^SELECT \* FROM "users" ORDER BY "Email"$`).
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" ORDER BY "email"$`).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
 	err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, ORDER_BY)).Find(&users).Error
 	s.NoError(err)
 }
 
+// TestFiltersOrderByRepeated is a test for ordering by the repeated and comma separated order_by params.
+func (s *TestSuite) TestFiltersOrderByRepeated() {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"order_by=last_name&order_by=first_name", `^SELECT \* FROM "users" ORDER BY "last_name" DESC,"first_name" DESC$`},
+		{"order_by=last_name,first_name&order_by=email&order_direction=asc", `^SELECT \* FROM "users" ORDER BY "last_name","first_name","email"$`},
+	}
+
+	for _, test := range tests {
+		s.Run(test.query, func() {
+			var users []User
+			ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: test.query}}}
+			s.mock.ExpectQuery(test.expected).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+			err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, ORDER_BY)).Find(&users).Error
+			s.NoError(err)
+		})
+	}
+}
+
 // TestFiltersAndSearcg is test for filtering and searching simultaneously.
 func (s *TestSuite) TestFiltersAndSearch() {
 	var users []User
@@ -272,7 +295,7 @@ func (s *TestSuite) TestFiltersAndSearch() {
 		},
 	}
 
-	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \(LOWER\("users"."username"\) LIKE \$1 OR LOWER\("users"."full_name"\) LIKE \$2\) AND "users"."username" = \$3$`).
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) AND "users"."username" = \$3$`).
 		WithArgs("%john%", "%john%", "sampleUser").
 		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
 
@@ -316,6 +339,100 @@ func (s *TestSuite) TestFiltersWithJoin() {
 	s.NoError(err)
 }
 
+// TestFiltersModelKinds is a test for filtering the slice, non-pointer and destination-only models.
+func (s *TestSuite) TestFiltersModelKinds() {
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=login:sampleUser",
+		},
+	}
+	tests := []struct {
+		name  string
+		query func(db *gorm.DB) *gorm.DB
+	}{
+		{"slice", func(db *gorm.DB) *gorm.DB { return db.Model(&[]User{}) }},
+		{"struct", func(db *gorm.DB) *gorm.DB { return db.Model(User{}) }},
+		{"dest", func(db *gorm.DB) *gorm.DB { return db }},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			var users []User
+			s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1$`).
+				WithArgs("sampleUser").
+				WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+			err := test.query(s.db).Scopes(FilterByQuery(&ctx, FILTER)).Find(&users).Error
+			s.NoError(err)
+		})
+	}
+}
+
 func TestRunSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
+
+// newBenchmarkDB opens the dry run DB, so the statements are built but not executed.
+func newBenchmarkDB(b *testing.B) *gorm.DB {
+	db, _, err := sqlmock.New()
+	require.NoError(b, err)
+	b.Cleanup(func() { db.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		DSN:                  "sqlmock_db_0",
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	}), &gorm.Config{DryRun: true, SkipDefaultTransaction: true})
+	require.NoError(b, err)
+	return gormDB
+}
+
+func BenchmarkFilterByQuery(b *testing.B) {
+	db := newBenchmarkDB(b)
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=login:sampleUser&filter=email~example&filter=id>=10&search=John&page=2&order_by=email",
+		},
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var users []User
+		db.Model(&User{}).Scopes(FilterByQuery(&ctx, ALL)).Find(&users)
+	}
+}
+
+func BenchmarkFilterByQueryScope(b *testing.B) {
+	db := newBenchmarkDB(b)
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=login:sampleUser&filter=email~example&filter=id>=10&search=John&page=2&order_by=email",
+		},
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FilterByQuery(&ctx, ALL)(db.Model(&User{}))
+	}
+}
+
+func BenchmarkFilterByQueryPaginate(b *testing.B) {
+	db := newBenchmarkDB(b)
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "page=2&page_size=20&order_by=email",
+		},
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FilterByQuery(&ctx, PAGINATE|ORDER_BY)(db.Model(&User{}))
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// The granularity operators match the times within the period, e.g. created_at@month:2024-05.
+const (
+	yearOperator  = "@year:"
+	monthOperator = "@month:"
+	weekOperator  = "@week:"
+	dayOperator   = "@day:"
+)
+
+// granularityOperators are the operators of the periods the time fields could be filtered with.
+var granularityOperators = []string{yearOperator, monthOperator, weekOperator, dayOperator}
+
+// granularityExpression builds the condition of the times within the period in the location, the start
+// of the period included and the start of the next one excluded, so the column indexes are still used.
+func granularityExpression(column interface{}, operator, value string, location *time.Location) (clause.Expression, error) {
+	start, end, err := parsePeriod(operator, value, location)
+	if err != nil {
+		return nil, err
+	}
+	return clause.And(clause.Gte{Column: column, Value: start}, clause.Lt{Column: column, Value: end}), nil
+}
+
+// parsePeriod returns the start of the period and the start of the next one: the year as 2024, the month
+// as 2024-05, the ISO week as 2024-W05 and the day as 2024-05-01.
+func parsePeriod(operator, value string, location *time.Location) (time.Time, time.Time, error) {
+	switch operator {
+	case yearOperator:
+		start, err := time.ParseInLocation("2006", value, location)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("year must be formatted as 2006")
+		}
+		return start, start.AddDate(1, 0, 0), nil
+	case monthOperator:
+		start, err := time.ParseInLocation("2006-01", value, location)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("month must be formatted as 2006-01")
+		}
+		return start, start.AddDate(0, 1, 0), nil
+	case weekOperator:
+		start, err := parseISOWeek(value, location)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		return start, start.AddDate(0, 0, 7), nil
+	default:
+		start, err := time.ParseInLocation(time.DateOnly, value, location)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("day must be formatted as 2006-01-02")
+		}
+		return start, start.AddDate(0, 0, 1), nil
+	}
+}
+
+// parseISOWeek returns the Monday of the ISO week, e.g. 2024-W05. The first week of the year is the one
+// with January 4th.
+func parseISOWeek(value string, location *time.Location) (time.Time, error) {
+	malformed := errors.New("week must be formatted as 2006-W01")
+	yearValue, weekValue, ok := strings.Cut(value, "-W")
+	if !ok || len(yearValue) != 4 || len(weekValue) != 2 {
+		return time.Time{}, malformed
+	}
+	year, err := strconv.Atoi(yearValue)
+	if err != nil {
+		return time.Time{}, malformed
+	}
+	week, err := strconv.Atoi(weekValue)
+	if err != nil || week < 1 {
+		return time.Time{}, malformed
+	}
+	january4 := time.Date(year, time.January, 4, 0, 0, 0, 0, location)
+	monday := january4.AddDate(0, 0, -(int(january4.Weekday())+6)%7+(week-1)*7)
+	if isoYear, isoWeek := monday.ISOWeek(); isoYear != year || isoWeek != week {
+		return time.Time{}, fmt.Errorf("year %d has no week %d", year, week)
+	}
+	return monday, nil
+}
+
+// isGranularityOperator reports whether the operator is one of the periods.
+func isGranularityOperator(operator string) bool {
+	return slices.Contains(granularityOperators, operator)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+type Payment struct {
+	Id        uint
+	PaidAt    time.Time `filter:"filterable"`
+	Reference string    `filter:"filterable"`
+}
+
+// TestFilterGranularity is a test for filtering the times within the periods.
+func (s *TestSuite) TestFilterGranularity() {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	s.Require().NoError(err)
+
+	tests := []struct {
+		filter string
+		opts   []Option
+		start  time.Time
+		end    time.Time
+	}{
+		{"paid_at@year:2024", nil, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"paid_at@month:2024-05", nil, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{"paid_at@month:2024-02", nil, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"paid_at@day:2024-02-29", nil, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		// The first ISO week of 2025 starts in 2024, the 53rd one of 2020 ends in 2021.
+		{"paid_at@week:2025-W01", nil, time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)},
+		{"paid_at@week:2020-W53", nil, time.Date(2020, 12, 28, 0, 0, 0, 0, time.UTC), time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)},
+		{"paid_at@week:2024-W09", nil, time.Date(2024, 2, 26, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"paid_at@month:2024-05", []Option{WithLocation(berlin)}, time.Date(2024, 5, 1, 0, 0, 0, 0, berlin), time.Date(2024, 6, 1, 0, 0, 0, 0, berlin)},
+	}
+	for _, test := range tests {
+		var payments []Payment
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/payments?filter="+test.filter, nil)}
+		s.mock.ExpectQuery(`^SELECT \* FROM "payments" WHERE "payments"."paid_at" >= \$1 AND "payments"."paid_at" < \$2$`).
+			WithArgs(test.start, test.end).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "paid_at", "reference"}))
+		err := s.db.Model(&Payment{}).Scopes(FilterByQuery(&ctx, FILTER, test.opts...)).Find(&payments).Error
+		s.NoError(err, test.filter)
+	}
+
+	// The malformed periods and the periods of the other fields are ignored.
+	for _, filter := range []string{"paid_at@month:May", "paid_at@week:2024-W53", "reference@day:2024-05-01"} {
+		var payments []Payment
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/payments?filter="+filter, nil)}
+		s.mock.ExpectQuery(`^SELECT \* FROM "payments"$`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "paid_at", "reference"}))
+		err := s.db.Model(&Payment{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&payments).Error
+		s.NoError(err, filter)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	errorTests := []struct {
+		filter string
+		reason string
+	}{
+		{"paid_at@month:May", "month must be formatted as 2006-01"},
+		{"paid_at@month:2024-13", "month must be formatted as 2006-01"},
+		{"paid_at@week:2024-W53", "year 2024 has no week 53"},
+		{"paid_at@week:2024-5", "week must be formatted as 2006-W01"},
+		{"paid_at@day:2023-02-29", "day must be formatted as 2006-01-02"},
+	}
+	for _, test := range errorTests {
+		var payments []Payment
+		r := httptest.NewRequest(http.MethodGet, "/payments?filter="+test.filter, nil)
+		err := s.db.Model(&Payment{}).Scopes(FilterByRequest(r, FILTER, WithStrict())).Find(&payments).Error
+		var filterErr *Error
+		s.Require().True(errors.As(err, &filterErr), test.filter)
+		s.Equal(test.reason, filterErr.Reason)
+	}
+}
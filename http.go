@@ -0,0 +1,38 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/url"
+
+	"gorm.io/gorm"
+)
+
+// FilterByRequest works like FilterByQuery for net/http requests, parsing the URL query directly.
+// Malformed query parameters are reported as the DB error.
+// Example:
+//
+//	err := db.Model(&User{}).Scopes(filter.FilterByRequest(r, filter.ALL)).Find(&users).Error
+func FilterByRequest(r *http.Request, config int, opts ...Option) func(db *gorm.DB) *gorm.DB {
+	o := newOptions(opts)
+	return filterByValues(requestValues(r, o), nil, ConfigFromBits(config), o, nil)
+}
+
+// requestValues returns the URL query of the request, with the filter header values added
+// to the filter params if it's configured.
+func requestValues(r *http.Request, o *options) url.Values {
+	values := r.URL.Query()
+	if o.filterHeader == "" {
+		return values
+	}
+	for _, phrase := range r.Header.Values(o.filterHeader) {
+		if phrase != "" {
+			values.Add("filter", phrase)
+		}
+	}
+	return values
+}
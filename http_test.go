@@ -0,0 +1,43 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// TestFilterByRequest is a test for filtering with the net/http request.
+func (s *TestSuite) TestFilterByRequest() {
+	var users []User
+	r := httptest.NewRequest(http.MethodGet, "/users?filter=login:sampleUser&search=John&page=2&order_by=email", nil)
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) AND "users"."username" = \$3 ORDER BY "email" DESC LIMIT \$4 OFFSET \$5$`).
+		WithArgs("%john%", "%john%", "sampleUser", 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByRequest(r, ALL)).Find(&users).Error
+	s.NoError(err)
+
+	ctx := gin.Context{Request: r}
+	dryRun := s.db.Session(&gorm.Session{DryRun: true})
+	expected := dryRun.Model(&User{}).Scopes(FilterByQuery(&ctx, ALL)).Find(&users).Statement
+	actual := dryRun.Model(&User{}).Scopes(FilterByRequest(r, ALL)).Find(&users).Statement
+	s.Equal(expected.SQL.String(), actual.SQL.String())
+	s.Equal(expected.Vars, actual.Vars)
+}
+
+// TestFilterByRequestError is a test for reporting malformed query parameters as the DB error.
+func (s *TestSuite) TestFilterByRequestError() {
+	var users []User
+	r := httptest.NewRequest(http.MethodGet, "/users?page=first", nil)
+
+	err := s.db.Model(&User{}).Scopes(FilterByRequest(r, ALL)).Find(&users).Error
+	s.Error(err)
+}
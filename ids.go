@@ -0,0 +1,129 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+
+	"gorm.io/gorm/clause"
+)
+
+// parseIDs parses the comma separated ids param, nil if it's absent and empty if there are no ids.
+func parseIDs(values url.Values, o *options) ([]string, error) {
+	if !values.Has("ids") {
+		return nil, nil
+	}
+	ids := splitFields(values["ids"])
+	if o.maxIDs > 0 && len(ids) > o.maxIDs {
+		return nil, &Error{Param: "ids", Value: values.Get("ids"), Reason: fmt.Sprintf("longer than %d ids", o.maxIDs)}
+	}
+	if ids == nil {
+		ids = []string{}
+	}
+	return ids, nil
+}
+
+// idsOrderParam is the order_by value keeping the order of the listed ids.
+const idsOrderParam = "ids"
+
+// idsExpression builds the IN condition of the primary key with the ids converted to its type.
+// The empty ids match no rows.
+func idsExpression(table string, meta *modelMeta, ids []string) (clause.Expression, error) {
+	if meta == nil || len(meta.primaryKeys) != 1 {
+		return nil, &Error{Param: "ids", Reason: "the model must have a single primary key"}
+	}
+	values, err := primaryKeyValues(meta, ids)
+	if err != nil {
+		return nil, err
+	}
+	return clause.IN{Column: clause.Column{Table: table, Name: meta.primaryKeys[0]}, Values: values}, nil
+}
+
+// primaryKeyValues converts the ids to the type of the primary key.
+func primaryKeyValues(meta *modelMeta, ids []string) ([]interface{}, error) {
+	values := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		value, err := primaryKeyValue(meta.primaryKeyType, id)
+		if err != nil {
+			return nil, &Error{Param: "ids", Value: id, Reason: err.Error()}
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// idsOrder orders the rows by the positions of their primary keys in the ids list, with array_position()
+// on postgres and the CASE of the positions for the other dialects.
+type idsOrder struct {
+	Column clause.Column
+	IDs    []interface{}
+}
+
+func (order idsOrder) Build(builder clause.Builder) {
+	if dialect(builder) == "postgres" {
+		builder.WriteString("array_position(ARRAY[")
+		for i, id := range order.IDs {
+			if i > 0 {
+				builder.WriteByte(',')
+			}
+			builder.AddVar(builder, id)
+		}
+		builder.WriteString("], ")
+		builder.WriteQuoted(order.Column)
+		builder.WriteByte(')')
+		return
+	}
+	builder.WriteString("CASE ")
+	builder.WriteQuoted(order.Column)
+	for i, id := range order.IDs {
+		builder.WriteString(" WHEN ")
+		builder.AddVar(builder, id)
+		builder.WriteString(" THEN " + strconv.Itoa(i))
+	}
+	builder.WriteString(" END")
+}
+
+// idsOrderExpression builds the order of the rows by the positions of the ids, nil if there are none,
+// since they match no rows anyway.
+func idsOrderExpression(table string, meta *modelMeta, ids []string) (clause.Expression, error) {
+	if meta == nil || len(meta.primaryKeys) != 1 {
+		return nil, &Error{Param: "ids", Reason: "the model must have a single primary key"}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	values, err := primaryKeyValues(meta, ids)
+	if err != nil {
+		return nil, err
+	}
+	return idsOrder{Column: clause.Column{Table: table, Name: meta.primaryKeys[0]}, IDs: values}, nil
+}
+
+// primaryKeyValue converts the id to the integer primary keys, the other ones are compared as strings.
+func primaryKeyValue(primaryKeyType reflect.Type, id string) (interface{}, error) {
+	if primaryKeyType == nil {
+		return id, nil
+	}
+	switch primaryKeyType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, err := strconv.ParseInt(id, 10, primaryKeyType.Bits())
+		if err != nil {
+			return nil, errors.New("must be an integer")
+		}
+		return value, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value, err := strconv.ParseUint(id, 10, primaryKeyType.Bits())
+		if err != nil {
+			return nil, errors.New("must be a non-negative integer")
+		}
+		return value, nil
+	}
+	return id, nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"database/sql/driver"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestFilterIDs is a test for filtering by the primary keys listed in the ids param.
+func (s *TestSuite) TestFilterIDs() {
+	tests := []struct {
+		query    string
+		expected string
+		args     []driver.Value
+	}{
+		{"ids=1,2,3", `WHERE "activities"."id" IN \(\$1,\$2,\$3\)`, []driver.Value{uint64(1), uint64(2), uint64(3)}},
+		{"ids=4&ids=5&filter=action:login", `WHERE "activities"."action" = \$1 AND "activities"."id" IN \(\$2,\$3\)`,
+			[]driver.Value{"login", uint64(4), uint64(5)}},
+		// The empty list matches no rows instead of all of them.
+		{"ids=", `WHERE "activities"."id" IN \(NULL\)`, nil},
+		{"", ``, nil},
+	}
+	for _, test := range tests {
+		var activities []Activity
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/activities?"+test.query, nil)}
+		expected := `^SELECT \* FROM "activities"$`
+		if test.expected != "" {
+			expected = `^SELECT \* FROM "activities" ` + test.expected + `$`
+		}
+		s.mock.ExpectQuery(expected).
+			WithArgs(test.args...).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "action", "created_at"}))
+		err := s.db.Model(&Activity{}).Scopes(FilterByQueryConfig(&ctx, Config{Filter: true, IDs: true})).Find(&activities).Error
+		s.NoError(err, test.query)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	invalid := map[string]*Error{
+		"ids=1,2,3": {Param: "ids", Value: "1,2,3", Reason: "longer than 2 ids"},
+		"ids=1,two": {Param: "ids", Value: "two", Reason: "must be a non-negative integer"},
+	}
+	for query, expected := range invalid {
+		var activities []Activity
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/activities?"+query, nil)
+		err := s.db.Model(&Activity{}).Scopes(FilterByQueryConfig(c, Config{IDs: true}, WithMaxIDs(2))).Find(&activities).Error
+		var filterErr *Error
+		s.True(errors.As(err, &filterErr), query)
+		s.Equal(expected, filterErr, query)
+	}
+}
+
+// TestIDsOrder is a test for keeping the order of the listed ids.
+func (s *TestSuite) TestIDsOrder() {
+	tests := []struct {
+		query    string
+		opts     []Option
+		expected string
+		args     []driver.Value
+	}{
+		{"ids=7,3,9&order_by=ids", nil,
+			`WHERE "activities"."id" IN \(\$1,\$2,\$3\) ORDER BY array_position\(ARRAY\[\$4,\$5,\$6\], "activities"."id"\)`,
+			[]driver.Value{uint64(7), uint64(3), uint64(9), uint64(7), uint64(3), uint64(9)}},
+		{"ids=7,3", []Option{WithIDsOrder()},
+			`WHERE "activities"."id" IN \(\$1,\$2\) ORDER BY array_position\(ARRAY\[\$3,\$4\], "activities"."id"\)`,
+			[]driver.Value{uint64(7), uint64(3), uint64(7), uint64(3)}},
+		// The explicit order and the absent ids keep the usual order.
+		{"ids=7,3&order_by=action&order_direction=asc", []Option{WithIDsOrder()},
+			`WHERE "activities"."id" IN \(\$1,\$2\) ORDER BY "action"`, []driver.Value{uint64(7), uint64(3)}},
+		{"", []Option{WithIDsOrder()}, `ORDER BY "id" DESC`, nil},
+		{"ids=", []Option{WithIDsOrder()}, `WHERE "activities"."id" IN \(NULL\)`, nil},
+	}
+	for _, test := range tests {
+		var activities []Activity
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/activities?"+test.query, nil)}
+		s.mock.ExpectQuery(`^SELECT \* FROM "activities" ` + test.expected + `$`).
+			WithArgs(test.args...).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "action", "created_at"}))
+		config := Config{Filter: true, OrderBy: true, IDs: true}
+		err := s.db.Model(&Activity{}).Scopes(FilterByQueryConfig(&ctx, config, test.opts...)).Find(&activities).Error
+		s.NoError(err, test.query)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+}
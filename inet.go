@@ -0,0 +1,41 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// containedOperator is the operator of the IP addresses contained in the network, e.g. ip<<10.0.0.0/8.
+const containedOperator = "<<"
+
+// inetOperators are the operators the Postgres inet fields could be filtered with.
+var inetOperators = []string{":", "!=", ">", ">=", "<", "<=", containedOperator}
+
+// inetDataType reports whether the column holds the Postgres IP addresses or networks.
+func inetDataType(dataType schema.DataType) bool {
+	return strings.EqualFold(string(dataType), "inet") || strings.EqualFold(string(dataType), "cidr")
+}
+
+// inetExpression builds the condition on the inet field, the value is validated before it's bound,
+// so the malformed addresses are rejected instead of failing the query.
+func inetExpression(column interface{}, operator, value string) (clause.Expression, error) {
+	if operator == containedOperator {
+		if _, err := netip.ParsePrefix(value); err != nil {
+			return nil, errors.New("value must be a CIDR network")
+		}
+		return clause.Expr{SQL: "? << ?::cidr", Vars: []interface{}{column, value}}, nil
+	}
+	if _, err := netip.ParseAddr(value); err != nil {
+		return nil, errors.New("value must be an IP address")
+	}
+	return comparisonExpression(column, operator, clause.Expr{SQL: "?::inet", Vars: []interface{}{value}}), nil
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+type Host struct {
+	Id      uint
+	Address string `gorm:"type:inet" filter:"filterable"`
+	Gateway string `filter:"filterable;inet"`
+	Name    string `filter:"filterable"`
+}
+
+// TestFilterINet is a test for filtering the IP addresses by the network and by equality.
+func (s *TestSuite) TestFilterINet() {
+	var hosts []Host
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/hosts?filter=address<<10.0.0.0/8,gateway:10.0.0.1", nil)}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "hosts" WHERE "hosts"."address" << \$1::cidr AND "hosts"."gateway" = \$2::inet$`).
+		WithArgs("10.0.0.0/8", "10.0.0.1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "address", "gateway", "name"}))
+	err := s.db.Model(&Host{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&hosts).Error
+	s.NoError(err)
+
+	// The network containment only applies to the inet fields, so the condition is ignored.
+	ctx = gin.Context{Request: httptest.NewRequest(http.MethodGet, "/hosts?filter=name<<10.0.0.0/8", nil)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "hosts"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "address", "gateway", "name"}))
+	err = s.db.Model(&Host{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&hosts).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	tests := []struct {
+		filter string
+		reason string
+	}{
+		{"address<<10.0.0.300/8", "value must be a CIDR network"},
+		{"address<<10.0.0.1", "value must be a CIDR network"},
+		{"gateway:localhost", "value must be an IP address"},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/hosts?filter="+test.filter, nil)
+		err = s.db.Model(&Host{}).Scopes(FilterByRequest(r, FILTER)).Find(&hosts).Error
+		var filterErr *Error
+		s.Require().True(errors.As(err, &filterErr), test.filter)
+		s.Equal("filter", filterErr.Param)
+		s.Equal(test.reason, filterErr.Reason)
+	}
+}
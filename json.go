@@ -0,0 +1,87 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// jsonOperators are the operators the keys of the JSON fields could be filtered with.
+var jsonOperators = []string{":", "!=", ">", ">=", "<", "<=", "~"}
+
+// jsonSerialized reports whether the field is stored with the gorm JSON serializer.
+func jsonSerialized(field *schema.Field) bool {
+	return field != nil && strings.EqualFold(field.TagSettings["SERIALIZER"], "json")
+}
+
+// jsonExtract is the text value of the key of the JSON column, built for the dialect. The key is bound
+// as the parameter, so it's never written into the SQL.
+type jsonExtract struct {
+	Column interface{}
+	Key    string
+}
+
+func (e jsonExtract) Build(builder clause.Builder) {
+	switch dialect(builder) {
+	case "postgres":
+		builder.AddVar(builder, e.Column)
+		builder.WriteString("->>")
+		builder.AddVar(builder, e.Key)
+	case "sqlite":
+		builder.WriteString("JSON_EXTRACT(")
+		builder.AddVar(builder, e.Column)
+		builder.WriteString(", ")
+		builder.AddVar(builder, e.path())
+		builder.WriteByte(')')
+	case "sqlserver":
+		builder.WriteString("JSON_VALUE(")
+		builder.AddVar(builder, e.Column)
+		builder.WriteString(", ")
+		builder.AddVar(builder, e.path())
+		builder.WriteByte(')')
+	default:
+		builder.WriteString("JSON_UNQUOTE(JSON_EXTRACT(")
+		builder.AddVar(builder, e.Column)
+		builder.WriteString(", ")
+		builder.AddVar(builder, e.path())
+		builder.WriteString("))")
+	}
+}
+
+// path returns the JSON path of the key, quoted so the dots and the other special characters
+// of the key are matched literally.
+func (e jsonExtract) path() string {
+	quoted, _ := json.Marshal(e.Key)
+	return "$." + string(quoted)
+}
+
+// jsonKey returns the key of the JSON field the filter param refers to, e.g. theme for preferences.theme.
+func jsonKey(field fieldMeta, term filterTerm) (string, bool) {
+	if !field.Filterable || field.Operator != "" || !slices.Contains(jsonOperators, term.operator) {
+		return "", false
+	}
+	key, ok := strings.CutPrefix(term.param, field.param+".")
+	return key, ok && key != ""
+}
+
+// jsonKeyField returns the field of the key of the JSON field, filtered as the text column.
+func jsonKeyField(field fieldMeta, key string) fieldMeta {
+	// gorm only builds the columns of the comparisons wrapped in clause.Expr.
+	column := clause.Expr{SQL: "?", Vars: []interface{}{jsonExtract{Column: field.column, Key: key}}}
+	field.param += "." + key
+	field.json = false
+	field.kind = reflect.String
+	field.valueType = TypeString
+	field.column = column
+	field.lowerColumn = clause.Expr{SQL: "LOWER(?)", Vars: []interface{}{column}}
+	return field
+}
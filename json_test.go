@@ -0,0 +1,53 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+type Subscriber struct {
+	Id          uint
+	Preferences map[string]string `gorm:"serializer:json" filter:"filterable"`
+}
+
+// TestFilterJSON is a test for filtering by the keys of the JSON serialized fields.
+func (s *TestSuite) TestFilterJSON() {
+	var subscribers []Subscriber
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/subscribers?filter=preferences.theme:dark,preferences.it's!=x", nil)}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "subscribers" WHERE "subscribers"."preferences"->>\$1 = \$2 AND "subscribers"."preferences"->>\$3 <> \$4$`).
+		WithArgs("theme", "dark", "it's", "x").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "preferences"}))
+	err := s.db.Model(&Subscriber{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&subscribers).Error
+	s.NoError(err)
+
+	// The whole JSON value isn't filtered.
+	ctx = gin.Context{Request: httptest.NewRequest(http.MethodGet, "/subscribers?filter=preferences:dark", nil)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "subscribers"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "preferences"}))
+	err = s.db.Model(&Subscriber{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&subscribers).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+// TestFilterJSON is a test for extracting the keys of the JSON serialized fields with MySQL.
+func (s *MySQLSuite) TestFilterJSON() {
+	var subscribers []Subscriber
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/subscribers?filter=preferences.theme:dark,preferences.it's!=x", nil)}
+
+	s.mock.ExpectQuery("^SELECT \\* FROM `subscribers` WHERE JSON_UNQUOTE\\(JSON_EXTRACT\\(`subscribers`.`preferences`, \\?\\)\\) = \\? "+
+		"AND JSON_UNQUOTE\\(JSON_EXTRACT\\(`subscribers`.`preferences`, \\?\\)\\) <> \\?$").
+		WithArgs(`$."theme"`, "dark", `$."it's"`, "x").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "preferences"}))
+	err := s.db.Model(&Subscriber{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&subscribers).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
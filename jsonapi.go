@@ -0,0 +1,129 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Syntax is the syntax of the query parameters.
+type Syntax int
+
+const (
+	// Legacy is the default syntax: search, filter, page, page_size, all, order_by and order_direction.
+	Legacy Syntax = iota
+	// JSONAPI adds the JSON:API page[number], page[size], sort, fields[type] and filter[param] keys.
+	// They take precedence over the legacy pagination and order keys, filter[param] keys are ANDed
+	// with the legacy filter ones.
+	JSONAPI
+)
+
+// WithSyntax sets the syntax of the query parameters.
+// Example:
+//
+//	// ?page[number]=2&page[size]=20&sort=-created_at,login&fields[users]=id,login&filter[login]=bob
+//	filter.FilterByQuery(c, filter.ALL, filter.WithSyntax(filter.JSONAPI))
+func WithSyntax(syntax Syntax) Option {
+	return func(o *options) {
+		o.syntax = syntax
+	}
+}
+
+// parseJSONAPIParams parses the JSON:API query parameters over the legacy ones.
+// The filter[param] value is compared for equality unless it starts with the operator, e.g. filter[age]=>=18.
+func parseJSONAPIParams(values url.Values, params *queryParams) error {
+	var err error
+	if value, ok := lookupParam(values, "page[number]"); ok {
+		if params.Page, err = parseInt(value); err != nil {
+			return &Error{Param: "page[number]", Value: value, Reason: "must be an integer"}
+		}
+	}
+	if value, ok := lookupParam(values, "page[size]"); ok {
+		if params.PageSize, err = parseInt(value); err != nil {
+			return &Error{Param: "page[size]", Value: value, Reason: "must be an integer"}
+		}
+	}
+	if value, ok := lookupParam(values, "sort"); ok && value != "" {
+		params.Sort = nil
+		for _, column := range strings.Split(value, ",") {
+			order := Order{Column: strings.TrimPrefix(column, "-"), Desc: strings.HasPrefix(column, "-")}
+			if order.Column == "" {
+				return &Error{Param: "sort", Value: value, Reason: "empty sort field"}
+			}
+			params.Sort = append(params.Sort, order)
+		}
+	}
+
+	// The keys are sorted, so the filter conditions are in the stable order.
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if member, ok := bracketedKey(key, "fields"); ok {
+			if params.Fieldsets == nil {
+				params.Fieldsets = map[string][]string{}
+			}
+			for _, value := range values[key] {
+				for _, field := range strings.Split(value, ",") {
+					if field != "" {
+						params.Fieldsets[member] = append(params.Fieldsets[member], field)
+					}
+				}
+			}
+		}
+		if member, ok := bracketedKey(key, "filter"); ok {
+			for _, value := range values[key] {
+				params.Filter = append(params.Filter, jsonAPIFilter(member, value))
+			}
+		}
+	}
+	return nil
+}
+
+// bracketedKey returns the member of the family[member] key.
+func bracketedKey(key, family string) (string, bool) {
+	if !strings.HasPrefix(key, family+"[") || !strings.HasSuffix(key, "]") {
+		return "", false
+	}
+	member := key[len(family)+1 : len(key)-1]
+	return member, member != "" && !strings.ContainsAny(member, "[]")
+}
+
+func jsonAPIFilter(param, value string) string {
+	for _, operator := range []string{"!=", ">=", "<=", ">", "<", "~", ":"} {
+		if strings.HasPrefix(value, operator) {
+			return param + value
+		}
+	}
+	return param + ":" + value
+}
+
+// fieldsetColumns returns the columns of the sparse fieldset requested for the query table.
+// The fields are selected the same way as with the fields param, see fieldsColumns.
+func fieldsetColumns(db *gorm.DB, query Query, o *options) ([]string, error) {
+	table := db.Statement.Table
+	if model := statementModel(db, o); table == "" && model != nil {
+		if err := db.Statement.Parse(model); err != nil {
+			return nil, nil
+		}
+		table = db.Statement.Table
+	}
+	if table == "" {
+		table = o.table
+	}
+	params, ok := query.Fieldsets[table]
+	if !ok {
+		return nil, nil
+	}
+
+	return fieldsColumns(db, "fields["+table+"]", params, o)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// TestJSONAPI is a test for the JSON:API query parameters of all the families at once.
+func (s *TestSuite) TestJSONAPI() {
+	var users []User
+	r := httptest.NewRequest(http.MethodGet, "/users?"+
+		"page%5Bnumber%5D=2&page%5Bsize%5D=20&sort=-email,id&filter%5Blogin%5D=bob&filter%5Bid%5D=%3E%3D3", nil)
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."id" >= \$1 AND "users"."username" = \$2 ORDER BY "email" DESC,"id" LIMIT \$3 OFFSET \$4$`).
+		WithArgs("3", "bob", 20, 20).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username"}))
+	err := s.db.Model(&User{}).Scopes(FilterByRequest(r, ALL, WithSyntax(JSONAPI))).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestJSONAPIFieldsets is a test for the sparse fieldsets gated by the selectable fields the same way as the fields param.
+func (s *TestSuite) TestJSONAPIFieldsets() {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"fields%5Bprofiles%5D=email", `SELECT "id","email" FROM "profiles"`},
+		{"fields%5Bprofiles%5D=login,password", `SELECT "id","username" FROM "profiles"`},
+		{"fields=login,password", `SELECT "id","username" FROM "profiles"`},
+		{"fields%5Bprofiles%5D=password", `SELECT * FROM "profiles"`},
+	}
+
+	dryRun := s.db.Session(&gorm.Session{DryRun: true})
+	for _, test := range tests {
+		s.Run(test.query, func() {
+			var profiles []Profile
+			ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/profiles?"+test.query, nil)}
+			statement := dryRun.Model(&Profile{}).
+				Scopes(FilterByQueryConfig(&ctx, Config{Fields: true}, WithSyntax(JSONAPI))).Find(&profiles).Statement
+			s.Equal(test.expected, statement.SQL.String())
+		})
+	}
+}
+
+// TestJSONAPIPrecedence is a test for the JSON:API keys mixed with the legacy ones.
+func (s *TestSuite) TestJSONAPIPrecedence() {
+	values := url.Values{
+		"page":            {"5"},
+		"page[number]":    {"2"},
+		"order_by":        {"login"},
+		"order_direction": {"asc"},
+		"sort":            {"-email"},
+		"filter":          {"email:john@example.com"},
+		"filter[login]":   {"bob"},
+		"fields[orders]":  {"id"},
+	}
+	query, err := ParseQuery(values, &User{}, ALL, WithSyntax(JSONAPI))
+	s.Require().NoError(err)
+	s.Equal(2, query.Page)
+	s.Equal("email", query.OrderBy)
+	s.True(query.OrderDesc)
+	s.Len(query.Filters, 2)
+	s.Equal(map[string][]string{"orders": {"id"}}, query.Fieldsets)
+
+	query, err = ParseQuery(values, &User{}, ALL)
+	s.Require().NoError(err)
+	s.Equal(5, query.Page)
+	s.Equal("login", query.OrderBy)
+	s.Len(query.Filters, 1)
+	s.Nil(query.Fieldsets)
+}
+
+// TestJSONAPIErrors is a test for the malformed JSON:API query parameters.
+func (s *TestSuite) TestJSONAPIErrors() {
+	var users []User
+	for _, query := range []string{"page%5Bsize%5D=ten", "sort=email,,id", "fields%5Busers%5D=password"} {
+		r := httptest.NewRequest(http.MethodGet, "/users?"+query, nil)
+		err := s.db.Model(&User{}).Scopes(FilterByRequest(r, ALL, WithSyntax(JSONAPI), WithStrict())).Find(&users).Error
+		var filterErr *Error
+		s.True(errors.As(err, &filterErr), query)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// jsonSchemaDraft is the JSON Schema version of the generated schemas.
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// jsonSchema is the subset of the JSON Schema describing the query parameters.
+type jsonSchema struct {
+	Schema      string                 `json:"$schema,omitempty"`
+	Title       string                 `json:"title,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Type        string                 `json:"type"`
+	Properties  map[string]*jsonSchema `json:"properties,omitempty"`
+	Items       *jsonSchema            `json:"items,omitempty"`
+	Enum        []string               `json:"enum,omitempty"`
+	Pattern     string                 `json:"pattern,omitempty"`
+	MaxLength   int                    `json:"maxLength,omitempty"`
+	Minimum     *int                   `json:"minimum,omitempty"`
+	Maximum     *int                   `json:"maximum,omitempty"`
+	Default     interface{}            `json:"default,omitempty"`
+}
+
+// JSONSchema returns the draft-07 JSON Schema of the query parameters accepted for the model with the config,
+// e.g. to validate the requests in the API gateway. The filter pattern only accepts the filterable params with
+// their operators, order_by lists the sortable params if there are any. The param names follow the syntax
+// set with WithSyntax, and the columns are named with the default naming strategy.
+// Example:
+//
+//	schema, err := filter.JSONSchema(&User{}, filter.ALL)
+func JSONSchema(model interface{}, config int, opts ...Option) ([]byte, error) {
+	o := newOptions(opts)
+	modelType := structType(model)
+	_, meta, ok := defaultQueryMeta(model, o)
+	if modelType == nil || !ok {
+		return nil, fmt.Errorf("filter: can't describe %T, model must be a struct", model)
+	}
+
+	c := ConfigFromBits(config)
+	properties := make(map[string]*jsonSchema)
+	if c.Search {
+		properties["search"] = &jsonSchema{Type: "string", MaxLength: o.maxSearchLength}
+		properties["search_case"] = &jsonSchema{Type: "string", Enum: []string{"sensitive", "insensitive"}}
+	}
+	if c.Filter {
+		properties["filter_logic"] = &jsonSchema{Type: "string", Enum: []string{"and", "or"}, Default: "and"}
+		properties["filter"] = &jsonSchema{
+			Type:  "array",
+			Items: &jsonSchema{Type: "string", Pattern: filterPattern(meta.fields)},
+		}
+		for _, field := range meta.fields {
+			if field.Filterable && field.Operator != "" {
+				properties[field.param] = &jsonSchema{Type: "string", MaxLength: o.maxFilterLength}
+			}
+			if field.Filterable && o.syntax == JSONAPI && !field.json {
+				properties["filter["+field.param+"]"] = &jsonSchema{Type: "string"}
+			}
+		}
+	}
+	if c.Paginate {
+		page, size := "page", "page_size"
+		if o.syntax == JSONAPI {
+			page, size = "page[number]", "page[size]"
+		}
+		properties[page] = &jsonSchema{Type: "integer", Minimum: intPointer(1), Default: 1}
+		properties[size] = &jsonSchema{Type: "integer", Minimum: intPointer(1), Maximum: intPointer(pageSizeLimit(model, o)), Default: defaultPageSize}
+		properties["all"] = &jsonSchema{Type: "boolean"}
+		if o.timeCursor != "" {
+			properties["after"] = &jsonSchema{Type: "string"}
+			properties["before"] = &jsonSchema{Type: "string"}
+		}
+	}
+	if c.OrderBy {
+		var sortable []string
+		for _, field := range meta.fields {
+			if field.Sortable {
+				sortable = append(sortable, field.param)
+			}
+		}
+		if o.syntax == JSONAPI {
+			properties["sort"] = &jsonSchema{Type: "string", Pattern: sortPattern(sortable, "-?")}
+		} else {
+			// order_by could be repeated and comma separated.
+			properties["order_by"] = &jsonSchema{Type: "array", Items: &jsonSchema{Type: "string", Pattern: sortPattern(sortable, "")}}
+			properties["order_direction"] = &jsonSchema{Type: "string", Enum: []string{"asc", "desc"}, Default: "desc"}
+		}
+	}
+
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	// The patterns are more readable without escaping the operators.
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(jsonSchema{
+		Schema:      jsonSchemaDraft,
+		Title:       modelType.Name(),
+		Description: "Query parameters of the " + modelType.Name() + " list.",
+		Type:        "object",
+		Properties:  properties,
+	})
+	return b.Bytes(), err
+}
+
+// filterPattern returns the pattern of the filter phrases of the filterable fields, the comma separated terms.
+func filterPattern(fields []fieldMeta) string {
+	terms := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if !field.Filterable {
+			continue
+		}
+		param := regexp.QuoteMeta(field.param)
+		if field.json {
+			// The keys of the JSON fields end at the operator.
+			param += `\.[^,:!<>~@&]+`
+		}
+		operators := fieldOperators(field)
+		for i, operator := range operators {
+			operators[i] = regexp.QuoteMeta(operator)
+		}
+		terms = append(terms, param+"(?:"+strings.Join(operators, "|")+")")
+	}
+	if len(terms) == 0 {
+		return "^$"
+	}
+	// The quoted values could contain commas, see unquoteValue.
+	term := "(?:" + strings.Join(terms, "|") + `)(?:"(?:[^"\\]|\\.)*"|[^,]*)`
+	return "^" + term + "(?:," + term + ")*$"
+}
+
+// sortPattern returns the pattern of the comma separated sortable params with the prefix, e.g. the optional minus
+// of the JSON:API sort param.
+func sortPattern(params []string, prefix string) string {
+	if len(params) == 0 {
+		return ""
+	}
+	quoted := make([]string, 0, len(params))
+	for _, param := range params {
+		quoted = append(quoted, regexp.QuoteMeta(param))
+	}
+	field := prefix + "(?:" + strings.Join(quoted, "|") + ")"
+	return "^" + field + "(?:," + field + ")*$"
+}
+
+func intPointer(value int) *int {
+	return &value
+}
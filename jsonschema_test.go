@@ -0,0 +1,88 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"encoding/json"
+	"os"
+	"regexp"
+)
+
+// TestJSONSchema is a test for the JSON Schema of the User query parameters, checked in as the golden file.
+func (s *TestSuite) TestJSONSchema() {
+	schema, err := JSONSchema(&User{}, ALL)
+	s.Require().NoError(err)
+	golden, err := os.ReadFile("testdata/user.schema.json")
+	s.Require().NoError(err)
+	s.Equal(string(golden), string(schema))
+
+	var parsed struct {
+		Properties struct {
+			Filter struct {
+				Items struct {
+					Pattern string `json:"pattern"`
+				} `json:"items"`
+			} `json:"filter"`
+		} `json:"properties"`
+	}
+	s.Require().NoError(json.Unmarshal(schema, &parsed))
+	pattern := regexp.MustCompile(parsed.Properties.Filter.Items.Pattern)
+	s.True(pattern.MatchString("login:john,id>=2"))
+	s.True(pattern.MatchString("email~example.com"))
+	s.False(pattern.MatchString("password:secret"))
+	s.False(pattern.MatchString("login"))
+	// The quoted values could contain commas.
+	s.True(pattern.MatchString(`login:"Doe, John",id>=2`))
+	s.True(pattern.MatchString(`login:"say \"hi\", bob"`))
+	s.False(pattern.MatchString(`login:"Doe, John",password:secret`))
+
+	_, err = JSONSchema(42, ALL)
+	s.EqualError(err, "filter: can't describe int, model must be a struct")
+}
+
+// TestJSONSchemaSyntax is a test for the JSON:API param names in the JSON Schema.
+func (s *TestSuite) TestJSONSchemaSyntax() {
+	type Book struct {
+		Id    uint   `filter:"param:id;filterable;sortable"`
+		Title string `filter:"param:title;filterable;sortable"`
+	}
+	schema, err := JSONSchema(&Book{}, PAGINATE|ORDER_BY|FILTER, WithSyntax(JSONAPI))
+	s.Require().NoError(err)
+
+	var parsed struct {
+		Properties map[string]map[string]interface{} `json:"properties"`
+	}
+	s.Require().NoError(json.Unmarshal(schema, &parsed))
+	s.Contains(parsed.Properties, "page[number]")
+	s.Contains(parsed.Properties, "filter[title]")
+	s.Equal(100.0, parsed.Properties["page[size]"]["maximum"])
+	s.Equal(`^-?(?:id|title)(?:,-?(?:id|title))*$`, parsed.Properties["sort"]["pattern"])
+	s.NotContains(parsed.Properties, "order_by")
+}
+
+// TestJSONSchemaOrderBy is a test for the repeated and comma separated order_by params in the JSON Schema.
+func (s *TestSuite) TestJSONSchemaOrderBy() {
+	schema, err := JSONSchema(&Release{}, ORDER_BY)
+	s.Require().NoError(err)
+
+	var parsed struct {
+		Properties struct {
+			OrderBy struct {
+				Type  string `json:"type"`
+				Items struct {
+					Pattern string `json:"pattern"`
+				} `json:"items"`
+			} `json:"order_by"`
+		} `json:"properties"`
+	}
+	s.Require().NoError(json.Unmarshal(schema, &parsed))
+	s.Equal("array", parsed.Properties.OrderBy.Type)
+	pattern := regexp.MustCompile(parsed.Properties.OrderBy.Items.Pattern)
+	s.True(pattern.MatchString("version"))
+	s.True(pattern.MatchString("title,version"))
+	s.False(pattern.MatchString("notes"))
+	s.False(pattern.MatchString("title,"))
+}
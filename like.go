@@ -0,0 +1,158 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const defaultLikeEscape = '\\'
+
+// like is the LIKE expression with the ESCAPE clause.
+type like struct {
+	Column interface{}
+	Value  interface{}
+	Escape rune
+}
+
+func (l like) Build(builder clause.Builder) {
+	l.build(builder, " LIKE ")
+}
+
+func (l like) NegationBuild(builder clause.Builder) {
+	l.build(builder, " NOT LIKE ")
+}
+
+func (l like) build(builder clause.Builder, operator string) {
+	builder.AddVar(builder, l.Column)
+	builder.WriteString(operator)
+	builder.AddVar(builder, l.Value)
+	writeEscape(builder, l.Escape)
+}
+
+// writeEscape writes the ESCAPE clause of the escape character.
+func writeEscape(builder clause.Builder, escape rune) {
+	literal := strings.ReplaceAll(string(escape), "'", "''")
+	// MySQL treats the backslash as the escape character in string literals
+	if dialect(builder) == "mysql" {
+		literal = strings.ReplaceAll(literal, `\`, `\\`)
+	}
+	builder.WriteString(" ESCAPE '" + literal + "'")
+}
+
+// dialect returns the name of the dialector of the statement, empty for the other builders.
+func dialect(builder clause.Builder) string {
+	if stmt, ok := builder.(*gorm.Statement); ok && stmt.Dialector != nil {
+		return stmt.Dialector.Name()
+	}
+	return ""
+}
+
+// searchLike is the case-insensitive search expression of the lowered pattern, built for the dialect:
+// ILIKE for postgres unless the column is citext, plain LIKE for sqlite, where LIKE is case-insensitive
+// for ASCII anyway, and for mysql, relying on the case-insensitive collations unless the field is tagged
+// as `searchable:ci`. LOWER(column) LIKE is built otherwise.
+type searchLike struct {
+	Column interface{}
+	Lower  interface{} // LOWER(column) expression of the field
+	Value  interface{}
+	Escape rune // zero if nothing was escaped
+	// Tagged fields are lowered for mysql, since their collation could be case-sensitive.
+	Tagged bool
+	// CIText columns are case-insensitive on their own, ILIKE only defeats their indexes.
+	CIText bool
+}
+
+func (l searchLike) Build(builder clause.Builder) {
+	l.build(builder, "")
+}
+
+func (l searchLike) NegationBuild(builder clause.Builder) {
+	l.build(builder, "NOT ")
+}
+
+func (l searchLike) build(builder clause.Builder, not string) {
+	column, operator := l.Lower, "LIKE"
+	switch dialect(builder) {
+	case "postgres":
+		column = l.Column
+		if !l.CIText {
+			operator = "ILIKE"
+		}
+	case "sqlite":
+		column = l.Column
+	case "mysql":
+		if !l.Tagged {
+			column = l.Column
+		}
+	}
+	builder.AddVar(builder, column)
+	builder.WriteString(" " + not + operator + " ")
+	builder.AddVar(builder, l.Value)
+	if l.Escape != 0 {
+		writeEscape(builder, l.Escape)
+	}
+}
+
+// escapeLike escapes the LIKE wildcards and the escape character in the value,
+// reporting whether anything was escaped.
+func escapeLike(value string, escape rune) (string, bool) {
+	if !strings.ContainsAny(value, "%_") && !strings.ContainsRune(value, escape) {
+		return value, false
+	}
+
+	var builder strings.Builder
+	builder.Grow(len(value) + 2)
+	for _, r := range value {
+		if r == '%' || r == '_' || r == escape {
+			builder.WriteRune(escape)
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String(), true
+}
+
+// likePattern is the LIKE pattern with the escaped wildcards of the value.
+// The value is boxed once, so the expressions of the fields share it.
+type likePattern struct {
+	value   interface{}
+	escaped bool
+}
+
+// likePattern builds the pattern, wrapping the escaped value with the prefix and the suffix.
+func (o *options) likePattern(value, prefix, suffix string) likePattern {
+	escaped, ok := escapeLike(value, o.likeEscape)
+	if prefix != "" || suffix != "" {
+		escaped = prefix + escaped + suffix
+	}
+	return likePattern{value: escaped, escaped: ok}
+}
+
+// likeExpression builds the LIKE expression of the pattern, with the ESCAPE clause if anything was escaped.
+func (o *options) likeExpression(column interface{}, pattern likePattern) clause.Expression {
+	if !pattern.escaped {
+		return clause.Like{Column: column, Value: pattern.value}
+	}
+	return like{Column: column, Value: pattern.value, Escape: o.likeEscape}
+}
+
+// searchLikeExpression builds the case-insensitive search expression of the field and the lowered pattern.
+func (o *options) searchLikeExpression(field *fieldMeta, pattern likePattern) clause.Expression {
+	expression := searchLike{
+		Column: field.column,
+		Lower:  field.lowerColumn,
+		Value:  pattern.value,
+		Tagged: field.SearchCase == CaseInsensitive,
+		CIText: field.citext,
+	}
+	if pattern.escaped {
+		expression.Escape = o.likeEscape
+	}
+	return expression
+}
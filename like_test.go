@@ -0,0 +1,150 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestLikeEscape is a test for escaping the LIKE wildcards in the search and the filter values.
+func (s *TestSuite) TestLikeEscape() {
+	var users []User
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "search=50%25_off%5C&filter=login~a%25b_c%5C",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 ESCAPE '\\' OR "users"."full_name" ILIKE \$2 ESCAPE '\\'\) AND "users"."username" LIKE \$3 ESCAPE '\\'$`).
+		WithArgs(`%50\%\_off\\%`, `%50\%\_off\\%`, `a\%b\_c\\`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, SEARCH|FILTER)).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestLikeEscapeCharacter is a test for the configurable escape character.
+func (s *TestSuite) TestLikeEscapeCharacter() {
+	var users []User
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "search=50%25_off!&filter=login~a%25b_c%5C",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 ESCAPE '!' OR "users"."full_name" ILIKE \$2 ESCAPE '!'\) AND "users"."username" LIKE \$3 ESCAPE '!'$`).
+		WithArgs(`%50!%!_off!!%`, `%50!%!_off!!%`, `a!%b!_c\`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, SEARCH|FILTER, WithLikeEscape('!'))).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestLikeWithoutWildcards is a test for the LIKE expressions without wildcards to escape.
+func (s *TestSuite) TestLikeWithoutWildcards() {
+	var users []User
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=login~samp",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" LIKE \$1$`).
+		WithArgs("samp").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, FILTER, WithLikeEscape('!'))).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestLikeFilterWildcards is a test for the wildcards of the like filter values, escaped unless WithRawLike is set.
+func (s *TestSuite) TestLikeFilterWildcards() {
+	tests := []struct {
+		value    string
+		opts     []Option
+		escape   bool
+		expected string
+	}{
+		{"%25", nil, true, `\%`},
+		{"a_c", nil, true, `a\_c`},
+		{"50%25_off", nil, true, `50\%\_off`},
+		{"50%25_off", []Option{WithRawLike()}, false, `50%_off`},
+		{"a%5Cc", []Option{WithRawLike()}, false, `a\c`},
+	}
+
+	for _, test := range tests {
+		s.Run(test.value, func() {
+			var users []User
+			ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: "filter=login~" + test.value}}}
+			expected := `^SELECT \* FROM "users" WHERE "users"."username" LIKE \$1$`
+			if test.escape {
+				expected = `^SELECT \* FROM "users" WHERE "users"."username" LIKE \$1 ESCAPE '\\'$`
+			}
+			s.mock.ExpectQuery(expected).
+				WithArgs(test.expected).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+			err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, FILTER, test.opts...)).Find(&users).Error
+			s.NoError(err)
+		})
+	}
+}
+
+type Note struct {
+	Id    uint
+	Title string `filter:"filterable"`
+	Code  string `filter:"filterable;searchable:cs"`
+	Tag   string `filter:"filterable;searchable:ci"`
+}
+
+// TestInsensitiveLike is a test for the case-insensitive like filters, unless the fields are tagged as case-sensitive.
+func (s *TestSuite) TestInsensitiveLike() {
+	tests := []struct {
+		filter   string
+		opts     []Option
+		expected string
+		arg      string
+	}{
+		{"title~Draft", nil, `"notes"."title" LIKE \$1`, "Draft"},
+		{"title~Draft", []Option{WithInsensitiveLike()}, `"notes"."title" ILIKE \$1`, "draft"},
+		{"title~50%25", []Option{WithInsensitiveLike()}, `"notes"."title" ILIKE \$1 ESCAPE '\\'`, `50\%`},
+		{"title~Dr%25", []Option{WithInsensitiveLike(), WithRawLike()}, `"notes"."title" ILIKE \$1`, "dr%"},
+		{"code~AB", []Option{WithInsensitiveLike()}, `"notes"."code" LIKE \$1`, "AB"},
+	}
+	for _, test := range tests {
+		s.Run(test.filter, func() {
+			var notes []Note
+			ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: "filter=" + test.filter}}}
+			s.mock.ExpectQuery(`^SELECT \* FROM "notes" WHERE ` + test.expected + `$`).
+				WithArgs(test.arg).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "title", "code", "tag"}))
+			err := s.db.Model(&Note{}).Scopes(FilterByQuery(&ctx, FILTER, test.opts...)).Find(&notes).Error
+			s.NoError(err)
+		})
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+type Voucher struct {
+	Id     uint
+	Number string `filter:"filterable;rawlike"`
+	Label  string `filter:"filterable"`
+}
+
+// TestRawLikeField is a test for passing the like filter values of the raw fields verbatim.
+func (s *TestSuite) TestRawLikeField() {
+	var vouchers []Voucher
+	ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: "filter=number~INV-2024-__%25,label~50%25_off"}}}
+	s.mock.ExpectQuery(`^SELECT \* FROM "vouchers" WHERE "vouchers"."number" LIKE \$1 AND "vouchers"."label" LIKE \$2 ESCAPE '\\'$`).
+		WithArgs("INV-2024-__%", `50\%\_off`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "number", "label"}))
+	err := s.db.Model(&Voucher{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&vouchers).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
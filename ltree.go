@@ -0,0 +1,48 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+const (
+	// descendantOperator is the operator of the paths under the path, e.g. path<@electronics.phones.
+	descendantOperator = "<@"
+	// ancestorOperator is the operator of the paths above the path, e.g. path@>electronics.phones.
+	ancestorOperator = "@>"
+)
+
+// ltreeOperators are the operators the Postgres ltree fields could be filtered with.
+var ltreeOperators = []string{":", "!=", descendantOperator, ancestorOperator}
+
+// ltreeRegexp matches the ltree paths, the dot separated labels of the letters, digits, underscores
+// and hyphens up to 1000 characters long.
+var ltreeRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{1,1000}(\.[A-Za-z0-9_-]{1,1000})*$`)
+
+// ltreeDataType reports whether the column holds the Postgres ltree paths.
+func ltreeDataType(dataType schema.DataType) bool {
+	return strings.EqualFold(string(dataType), "ltree")
+}
+
+// ltreeExpression builds the condition on the ltree field, the path is validated before it's bound,
+// so the malformed paths are rejected instead of failing the query.
+func ltreeExpression(column interface{}, operator, value string) (clause.Expression, error) {
+	if !ltreeRegexp.MatchString(value) {
+		return nil, errors.New("value must be an ltree path")
+	}
+	switch operator {
+	case descendantOperator, ancestorOperator:
+		return clause.Expr{SQL: "? " + operator + " ?::ltree", Vars: []interface{}{column, value}}, nil
+	default:
+		return comparisonExpression(column, operator, clause.Expr{SQL: "?::ltree", Vars: []interface{}{value}}), nil
+	}
+}
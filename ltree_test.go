@@ -0,0 +1,58 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+type Category struct {
+	Id   uint
+	Path string `filter:"ltree;filterable"`
+	Name string `filter:"filterable"`
+}
+
+// TestFilterLTree is a test for filtering the ltree paths by the descendants and the ancestors.
+func (s *TestSuite) TestFilterLTree() {
+	var categories []Category
+	tests := []struct {
+		filter   string
+		expected string
+	}{
+		{"path<@electronics.phones", `^SELECT \* FROM "categories" WHERE "categories"."path" <@ \$1::ltree$`},
+		{"path@>electronics.phones", `^SELECT \* FROM "categories" WHERE "categories"."path" @> \$1::ltree$`},
+		{"path:electronics.phones", `^SELECT \* FROM "categories" WHERE "categories"."path" = \$1::ltree$`},
+	}
+	for _, test := range tests {
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/categories?filter="+test.filter, nil)}
+		s.mock.ExpectQuery(test.expected).
+			WithArgs("electronics.phones").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "path", "name"}))
+		err := s.db.Model(&Category{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&categories).Error
+		s.NoError(err)
+	}
+
+	// The ltree operators only apply to the ltree fields, so the condition is ignored.
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/categories?filter=name<@electronics", nil)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "categories"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "path", "name"}))
+	err := s.db.Model(&Category{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&categories).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	r := httptest.NewRequest(http.MethodGet, "/categories?filter=path<@electronics..phones", nil)
+	err = s.db.Model(&Category{}).Scopes(FilterByRequest(r, FILTER)).Find(&categories).Error
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal("filter", filterErr.Param)
+	s.Equal("value must be an ltree path", filterErr.Reason)
+	s.Equal([]string{":", "!=", "<@", "@>"}, Describe(&Category{}).Fields[0].Operators)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// AppliedFilter describes a filter condition applied to the query.
+type AppliedFilter struct {
+	Param    string `json:"param"`
+	Column   string `json:"column"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// Meta describes the query parameters as they were applied to the query,
+// e.g. to echo them back in the response.
+type Meta struct {
+	AppliedFilters []AppliedFilter `json:"applied_filters"`
+	Search         string          `json:"search,omitempty"`
+	Page           int             `json:"page,omitempty"`
+	PageSize       int             `json:"page_size,omitempty"`
+	OrderBy        string          `json:"order_by,omitempty"`
+	OrderDesc      bool            `json:"order_desc,omitempty"`
+	// OrderFallback reports whether the rows are ordered by the fallback column instead of the requested one,
+	// see WithOrderFallback.
+	OrderFallback bool `json:"order_fallback,omitempty"`
+	// DeduplicatedFilters is the number of the filter conditions dropped as the exact duplicates.
+	DeduplicatedFilters int `json:"deduplicated_filters,omitempty"`
+	// WithCount reports whether the client asked for the total with with_count=true.
+	WithCount bool `json:"with_count,omitempty"`
+	// Total is the number of the models matching the search and the filters, set by FindPage
+	// only if the client asked for it.
+	Total *int64 `json:"total,omitempty"`
+}
+
+// ParseAndScope works like FilterByQuery, additionally returning the meta of the applied query parameters.
+// Note: the meta is filled when the scope is applied to the query.
+// Example:
+//
+//	scope, meta := filter.ParseAndScope(c, filter.ALL)
+//	err := db.Model(&User{}).Scopes(scope).Find(&users).Error
+//	c.JSON(http.StatusOK, gin.H{"data": users, "meta": meta})
+func ParseAndScope(c *gin.Context, config int, opts ...Option) (func(db *gorm.DB) *gorm.DB, *Meta) {
+	meta := &Meta{}
+	return filterByQuery(c, ConfigFromBits(config), newOptions(opts), meta), meta
+}
+
+func newMeta(query Query) Meta {
+	meta := Meta{
+		AppliedFilters:      make([]AppliedFilter, 0, len(query.Filters)),
+		Search:              query.Search,
+		OrderBy:             query.OrderBy,
+		OrderDesc:           query.OrderDesc,
+		OrderFallback:       query.orderFallback,
+		DeduplicatedFilters: query.Deduplicated,
+		WithCount:           query.WithCount,
+	}
+	for _, condition := range query.Filters {
+		meta.AppliedFilters = append(meta.AppliedFilters, AppliedFilter{
+			Param:    condition.Param,
+			Column:   condition.Column,
+			Operator: condition.Operator,
+			Value:    condition.Value,
+		})
+	}
+	if !query.All {
+		meta.Page = query.Page
+		meta.PageSize = query.PageSize
+	}
+	return meta
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestParseAndScopeMeta is a test for the meta of a mixed request.
+func (s *TestSuite) TestParseAndScopeMeta() {
+	var users []User
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=login:sampleUser&filter=id>=5&search=John&page=3&page_size=500&order_by=email&order_direction=asc",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) AND \("users"."username" = \$3 AND "users"."id" >= \$4\) ORDER BY "email" LIMIT \$5 OFFSET \$6$`).
+		WithArgs("%john%", "%john%", "sampleUser", "5", 100, 200).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	scope, meta := ParseAndScope(&ctx, ALL)
+	err := s.db.Model(&User{}).Scopes(scope).Find(&users).Error
+	s.NoError(err)
+
+	s.Equal(Meta{
+		AppliedFilters: []AppliedFilter{
+			{Param: "login", Column: "username", Operator: ":", Value: "sampleUser"},
+			{Param: "id", Column: "id", Operator: ">=", Value: "5"},
+		},
+		Search:    "John",
+		Page:      3,
+		PageSize:  100,
+		OrderBy:   "email",
+		OrderDesc: false,
+	}, *meta)
+}
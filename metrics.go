@@ -0,0 +1,48 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Metrics observes the usage of the query parameters, e.g. to index the columns the clients filter by.
+// The model is the name of the model struct, or the table configured with WithTable.
+type Metrics interface {
+	// ObserveFilter is called for every applied filter condition.
+	ObserveFilter(model, param, operator string)
+	// ObserveSearch is called for the applied search with the number of the words of the phrase.
+	ObserveSearch(model string, tokens int)
+	// ObservePageSize is called for the paginated queries with the page size.
+	ObservePageSize(model string, size int)
+}
+
+// WithMetrics sets the metrics observing the applied search, filter conditions and page size once per request.
+// See the filterprom package for the Prometheus metrics.
+func WithMetrics(metrics Metrics) Option {
+	return func(o *options) {
+		o.metrics = metrics
+	}
+}
+
+// observe reports the applied query to the metrics.
+func observe(metrics Metrics, db *gorm.DB, query Query, searched, paginated bool, o *options) {
+	model := o.table
+	if modelType := structType(statementModel(db, o)); modelType != nil {
+		model = modelType.Name()
+	}
+	if searched {
+		metrics.ObserveSearch(model, len(strings.Fields(query.Search)))
+	}
+	for _, condition := range query.Filters {
+		metrics.ObserveFilter(model, condition.Param, condition.Operator)
+	}
+	if paginated && !query.All {
+		metrics.ObservePageSize(model, query.PageSize)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+type recordedMetrics []string
+
+func (m *recordedMetrics) ObserveFilter(model, param, operator string) {
+	*m = append(*m, fmt.Sprintf("filter %s %s %s", model, param, operator))
+}
+
+func (m *recordedMetrics) ObserveSearch(model string, tokens int) {
+	*m = append(*m, fmt.Sprintf("search %s %d", model, tokens))
+}
+
+func (m *recordedMetrics) ObservePageSize(model string, size int) {
+	*m = append(*m, fmt.Sprintf("page_size %s %d", model, size))
+}
+
+// TestMetrics is a test for observing the applied query parameters once per request.
+func (s *TestSuite) TestMetrics() {
+	var (
+		users   []User
+		total   int64
+		metrics recordedMetrics
+	)
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet,
+		"/users?search=John+Smith&filter=login:sampleUser,id>=5&filter=password:secret&page_size=20", nil)}
+	scope := FilterByQuery(&ctx, SEARCH|FILTER|PAGINATE, WithMetrics(&metrics))
+
+	s.mock.ExpectQuery(`^SELECT count\(\*\) FROM "users" WHERE`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE .* LIMIT \$5$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	s.NoError(s.db.Model(&User{}).Scopes(scope).Count(&total).Error)
+	s.NoError(s.db.Model(&User{}).Scopes(scope).Find(&users).Error)
+	s.Equal(recordedMetrics{
+		"search User 2",
+		"filter User id >=",
+		"filter User login :",
+		"page_size User 20",
+	}, metrics)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
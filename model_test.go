@@ -0,0 +1,51 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestFilterByQueryWithModel is a test for filtering the query with the explicit model.
+func (s *TestSuite) TestFilterByQueryWithModel() {
+	var rows []map[string]interface{}
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?filter=login:bob", nil)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1$`).
+		WithArgs("bob").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username"}))
+	err := s.db.Table("users").Scopes(FilterByQueryWithModel(&ctx, FILTER, &User{})).Find(&rows).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+// TestFilterWithoutModel is a test for reporting the filters skipped without the model.
+func (s *TestSuite) TestFilterWithoutModel() {
+	var (
+		rows     []map[string]interface{}
+		messages []string
+	)
+	logger := func(msg string, fields map[string]interface{}) {
+		messages = append(messages, msg)
+	}
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?filter=login:bob", nil)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "users"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username"}))
+	err := s.db.Table("users").Scopes(FilterByQuery(&ctx, FILTER, WithDebugLogger(logger))).Find(&rows).Error
+	s.NoError(err)
+	s.Equal([]string{"filter skipped without the model"}, messages)
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	// The strict mode rejects the query.
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/users?filter=login:bob", nil)
+	err = s.db.Table("users").Scopes(FilterByQuery(c, FILTER, WithStrict())).Find(&rows).Error
+	s.True(errors.Is(err, ErrNoModel))
+}
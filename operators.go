@@ -0,0 +1,122 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"gorm.io/gorm/clause"
+)
+
+// OperatorBuilder builds the expression of the custom filter operator for the column and the value.
+type OperatorBuilder func(column clause.Column, value string, field FieldMeta) (clause.Expression, error)
+
+// FieldMeta is the field the custom operator is applied to, with the param and the column resolved.
+type FieldMeta struct {
+	Field
+	// Type is the type of the field values, empty for the table fields.
+	Type FieldType
+}
+
+// operatorSet is the snapshot of the custom operators, replaced on every registration,
+// so the filters are scanned without locking.
+type operatorSet struct {
+	builders map[string]OperatorBuilder
+	custom   []string // custom symbols in the registration order
+	symbols  []string // all the symbols, the longest first
+	chars    string   // characters the symbols start with
+}
+
+var (
+	operators     atomic.Pointer[operatorSet]
+	operatorsLock sync.Mutex
+	// operatorSymbolRegexp matches the symbols which can't be confused with the params and the values.
+	operatorSymbolRegexp = regexp.MustCompile(`^[^\w,\s]+$`)
+)
+
+// RegisterOperator registers the custom filter operator for all the filterable fields, process-wide.
+// The longest symbol is matched first, so the custom symbols aren't shadowed by the built-in ones,
+// the errors of the builder reject the request with the *Error.
+// Example:
+//
+//	err := filter.RegisterOperator("@@", func(column clause.Column, value string, field filter.FieldMeta) (clause.Expression, error) {
+//		return clause.Expr{SQL: "to_tsvector(?) @@ plainto_tsquery(?)", Vars: []interface{}{column, value}}, nil
+//	})
+//	// ?filter=title@@party
+func RegisterOperator(symbol string, build OperatorBuilder) error {
+	if !operatorSymbolRegexp.MatchString(symbol) {
+		return fmt.Errorf("filter: operator %q must consist of the punctuation characters", symbol)
+	}
+	if slices.Contains(filterOperators[:], symbol) {
+		return fmt.Errorf("filter: operator %q is built-in", symbol)
+	}
+	if build == nil {
+		return errors.New("filter: operator builder is nil")
+	}
+
+	operatorsLock.Lock()
+	defer operatorsLock.Unlock()
+	set := &operatorSet{builders: make(map[string]OperatorBuilder)}
+	if previous := operators.Load(); previous != nil {
+		for custom, builder := range previous.builders {
+			set.builders[custom] = builder
+		}
+		set.custom = slices.Clone(previous.custom)
+	}
+	if _, ok := set.builders[symbol]; !ok {
+		set.custom = append(set.custom, symbol)
+	}
+	set.builders[symbol] = build
+
+	set.symbols = append(filterOperators[:len(filterOperators):len(filterOperators)], set.custom...)
+	slices.SortStableFunc(set.symbols, func(a, b string) int { return len(b) - len(a) })
+	set.chars = operatorChars
+	for _, custom := range set.custom {
+		if first := custom[:1]; !strings.Contains(set.chars, first) {
+			set.chars += first
+		}
+	}
+	operators.Store(set)
+	return nil
+}
+
+// lookupOperator returns the builder of the custom operator, nil for the built-in ones.
+func lookupOperator(symbol string) OperatorBuilder {
+	if set := operators.Load(); set != nil {
+		return set.builders[symbol]
+	}
+	return nil
+}
+
+// customOperators returns the symbols of the custom operators in the registration order.
+func customOperators() []string {
+	if set := operators.Load(); set != nil {
+		return set.custom
+	}
+	return nil
+}
+
+// customExpression builds the expression of the custom operator on the field.
+func customExpression(build OperatorBuilder, field fieldMeta, value string) (clause.Expression, error) {
+	column, ok := field.column.(clause.Column)
+	if !ok {
+		// The aggregates are the raw expressions.
+		column = clause.Column{Name: field.Aggregate, Raw: true}
+	}
+	meta := FieldMeta{Field: field.Field, Type: field.valueType}
+	meta.Param = field.param
+	expression, err := build(column, value, meta)
+	if err == nil && expression == nil {
+		err = errors.New("operator built no expression")
+	}
+	return expression, err
+}
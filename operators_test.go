@@ -0,0 +1,97 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"database/sql/driver"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm/clause"
+)
+
+// TestRegisterOperator is a test for filtering with the custom operators.
+func (s *TestSuite) TestRegisterOperator() {
+	defer operators.Store(operators.Load())
+	s.Require().NoError(RegisterOperator("@@", func(column clause.Column, value string, field FieldMeta) (clause.Expression, error) {
+		s.Equal("login", field.Param)
+		s.Equal(TypeString, field.Type)
+		return clause.Expr{SQL: "to_tsvector(?) @@ plainto_tsquery(?)", Vars: []interface{}{column, value}}, nil
+	}))
+	s.Require().NoError(RegisterOperator("~*", func(column clause.Column, value string, field FieldMeta) (clause.Expression, error) {
+		return clause.Expr{SQL: "? ~* ?", Vars: []interface{}{column, value}}, nil
+	}))
+	s.Error(RegisterOperator(">=", nil))
+	s.Error(RegisterOperator("in", nil))
+
+	var users []User
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?filter=login@@john&filter=email~*^j&filter=id>=2", nil)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE to_tsvector\("users"."username"\) @@ plainto_tsquery\(\$1\) `+
+		`AND "users"."email" ~\* \$2 AND "users"."id" >= \$3$`).
+		WithArgs("john", "^j", "2").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&users).Error
+	s.NoError(err)
+
+	s.Equal([]string{":", "!=", ">", ">=", "<", "<=", "~", "@@", "~*"}, Describe(&User{}).Fields[0].Operators)
+}
+
+// TestRegisterOperatorError is a test for rejecting the request with the error of the custom operator.
+func (s *TestSuite) TestRegisterOperatorError() {
+	defer operators.Store(operators.Load())
+	s.Require().NoError(RegisterOperator("<->", func(column clause.Column, value string, field FieldMeta) (clause.Expression, error) {
+		return nil, errors.New("distance must be a point")
+	}))
+
+	var users []User
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/users?filter=id<->x", nil)
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(ctx, FILTER)).Find(&users).Error
+
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal("id<->x", filterErr.Value)
+	s.Equal("distance must be a point", filterErr.Reason)
+}
+
+// TestArrayIn is a test for binding the IN lists of the custom operators as the single array parameter.
+func (s *TestSuite) TestArrayIn() {
+	defer operators.Store(operators.Load())
+	s.Require().NoError(RegisterOperator("^", func(column clause.Column, value string, field FieldMeta) (clause.Expression, error) {
+		var values []interface{}
+		for _, v := range strings.Split(value, "|") {
+			values = append(values, v)
+		}
+		return clause.IN{Column: column, Values: values}, nil
+	}))
+
+	tests := []struct {
+		options  []Option
+		expected string
+		args     []driver.Value
+	}{
+		{nil, `"users"."id" IN \(\$1,\$2,\$3,\$4,\$5\)`, []driver.Value{"1", "2", "3", "4", "5"}},
+		{[]Option{WithArrayIn()}, `"users"."id" = ANY\(\$1\)`, []driver.Value{`{"1","2","3","4","5"}`}},
+	}
+	for _, test := range tests {
+		var users []User
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?filter=id^1|2|3|4|5", nil)}
+		s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE ` + test.expected + `$`).
+			WithArgs(test.args...).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+		err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, FILTER, test.options...)).Find(&users).Error
+		s.NoError(err)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	value, err := arrayValue{`a"b`, nil, `c\d`}.Value()
+	s.NoError(err)
+	s.Equal(`{"a\"b",NULL,"c\\d"}`, value)
+}
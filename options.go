@@ -0,0 +1,506 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultMaxSearchLength = 256
+	defaultMaxFilterLength = 128
+	defaultMaxIDs          = 100
+)
+
+// Kind is the kind of the generated expressions group.
+type Kind int
+
+const (
+	KindSearch Kind = iota // Expressions of the search phrase
+	KindFilter             // Expressions of the filter param
+)
+
+// Combinator is the predicate joining the search expressions of the searchable fields.
+type Combinator int
+
+const (
+	Or  Combinator = iota // The phrase matches any of the fields, by default
+	And                   // The phrase matches all the fields
+)
+
+// EmptyPolicy is the meaning of the empty values of the equality filters on the string columns.
+type EmptyPolicy int
+
+const (
+	EmptyMeansEmptyString EmptyPolicy = iota // `login:` matches the empty strings, by default
+	EmptyMeansNullOrEmpty                    // `login:` also matches NULL on the nullable columns
+	EmptyRejected                            // `login:` is rejected with the 400 error
+)
+
+// ConflictPolicy is the handling of the equality filters on the same column with the different values,
+// e.g. filter=id:1&filter=id:2, which match no rows when they're ANDed.
+type ConflictPolicy int
+
+const (
+	ConflictsAnded        ConflictPolicy = iota // the conditions are ANDed as is, by default
+	ConflictsIn                                 // the conditions are merged into the IN list of the values
+	ConflictsMatchNothing                       // the query matches no rows without hitting the database
+	ConflictsRejected                           // the query is rejected with the 400 error
+)
+
+// Option customizes the filtering behavior.
+type Option func(*options)
+
+type options struct {
+	forcedConditions func(c *gin.Context) []clause.Expression
+	table            string
+	tableFields      Fields
+	expressionHook   func(kind Kind, exprs []clause.Expression) []clause.Expression
+	plainSearch      bool
+	searchCombinator Combinator
+	filterOr         bool
+	caseSensitive    bool // the param names are matched byte-exact
+	idSearch         bool
+	relevanceOrder   bool
+	orderFallback    []string
+	timeCursor       string
+	likeEscape       rune
+	rawLike          bool
+	insensitiveLike  bool
+	arrayIn          bool
+	location         *time.Location
+	trimSpace        bool
+	nullNotEqual     bool
+	emptyPolicy      EmptyPolicy
+	conflictPolicy   ConflictPolicy
+	maxSearchLength  int
+	maxFilterLength  int
+	maxIDs           int
+	maxFuzzyDistance int
+	maxPageSize      int
+	distinctCount    bool
+	idsOrder         bool
+	filterHeader     string
+	auditHook        func(c *gin.Context, query Query)
+	allowAll         func(c *gin.Context) bool
+	fieldPolicy      func(c *gin.Context, field FieldMeta) Capability
+	metrics          Metrics
+	safeWrite        bool
+	required         []string
+	debugLogger      func(msg string, fields map[string]interface{})
+	strict           bool
+	errorHandler     func(err error) error
+	syntax           Syntax
+	// request and policyMetas are set for the request with the field policy, see forRequest.
+	request     *gin.Context
+	policyMetas map[*modelMeta]*modelMeta
+	// skipConditions disables search, filter and forced conditions, used by the Plugin
+	// when conditions were already applied to the statement.
+	skipConditions bool
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{
+		likeEscape:       defaultLikeEscape,
+		location:         time.UTC,
+		maxSearchLength:  defaultMaxSearchLength,
+		maxFilterLength:  defaultMaxFilterLength,
+		maxIDs:           defaultMaxIDs,
+		maxFuzzyDistance: defaultMaxFuzzyDistance,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithForcedConditions adds server-side conditions which are always ANDed with the request ones,
+// even when SEARCH and FILTER flags are off, e.g. for multi-tenancy. The gin context is nil
+// for the adapters of the other frameworks:
+//
+//	filter.WithForcedConditions(func(c *gin.Context) []clause.Expression {
+//		return []clause.Expression{clause.Eq{Column: "tenant_id", Value: c.GetUint("tenant_id")}}
+//	})
+func WithForcedConditions(conditions func(c *gin.Context) []clause.Expression) Option {
+	return func(o *options) {
+		o.forcedConditions = conditions
+	}
+}
+
+// WithTable configures the fields for db.Table queries without a model, so they could be searched,
+// filtered and ordered. Columns are qualified with the given table name or alias:
+//
+//	db.Table("user_stats").Scopes(filter.FilterByQuery(c, filter.ALL, filter.WithTable("user_stats",
+//		filter.Field{Name: "Username", Param: "login", Filterable: true},
+//		filter.Field{Name: "Visits", Column: "visits_count", Filterable: true},
+//	))).Find(&stats)
+func WithTable(table string, fields ...Field) Option {
+	return func(o *options) {
+		o.table = table
+		o.tableFields = fields
+	}
+}
+
+// WithExpressionHook sets the hook to inspect and rewrite the generated expressions before they're
+// applied to the query. It's called once for the search group and once per filter param group,
+// returning nil drops the group. The column expressions are shared by the requests, so they
+// should be replaced rather than modified in place.
+func WithExpressionHook(hook func(kind Kind, exprs []clause.Expression) []clause.Expression) Option {
+	return func(o *options) {
+		o.expressionHook = hook
+	}
+}
+
+// hook returns the expression hook bound to the kind, nil if it's not set.
+func (o *options) hook(kind Kind) func([]clause.Expression) []clause.Expression {
+	if o.expressionHook == nil {
+		return nil
+	}
+	return func(exprs []clause.Expression) []clause.Expression {
+		return o.expressionHook(kind, exprs)
+	}
+}
+
+// withSearchCase returns the options with the search case requested by the client, the fields tagged
+// with their own case keep it.
+func (o *options) withSearchCase(searchCase Case) *options {
+	if searchCase == CaseDefault || (searchCase == CaseSensitive) == o.plainSearch {
+		return o
+	}
+	requested := *o
+	requested.plainSearch = searchCase == CaseSensitive
+	return &requested
+}
+
+// WithPlainSearch disables the case-insensitive search, emitting plain `column LIKE ?` with the phrase
+// unmodified, e.g. for Postgres columns with case-insensitive collations, where ILIKE only defeats indexes.
+// Fields tagged as `searchable:ci` are still searched case-insensitively.
+func WithPlainSearch() Option {
+	return func(o *options) {
+		o.plainSearch = true
+	}
+}
+
+// WithSearchCombinator sets the predicate joining the search expressions of the searchable fields, Or by default.
+// Example:
+//
+//	// the phrase must appear in both the title and the body
+//	filter.FilterByQuery(c, filter.SEARCH, filter.WithSearchCombinator(filter.And))
+func WithSearchCombinator(combinator Combinator) Option {
+	return func(o *options) {
+		o.searchCombinator = combinator
+	}
+}
+
+// WithIDSearch makes the integer search phrases also match the primary key by equality, e.g. for the IDs
+// pasted into the search box, unless some fields are tagged as `searchable:id`.
+func WithIDSearch() Option {
+	return func(o *options) {
+		o.idSearch = true
+	}
+}
+
+// WithRelevanceOrder orders the searched rows by the relevance to the phrase unless the client orders them
+// explicitly: the rows with the fields starting with the phrase come first, then the ones containing it.
+// The default order is the tiebreaker.
+func WithRelevanceOrder() Option {
+	return func(o *options) {
+		o.relevanceOrder = true
+	}
+}
+
+// WithOrderFallback checks the columns the client orders by against the sortable fields and replaces
+// the invalid one, unknown or not sortable, with the first of the fallback columns the model has, then
+// with the primary key. The invalid secondary orders are dropped. In the strict mode the invalid columns
+// are rejected instead. The applied order is reported in the meta.
+// Example:
+//
+//	filter.FilterByQuery(c, filter.ALL, filter.WithOrderFallback("created_at"))
+func WithOrderFallback(columns ...string) Option {
+	return func(o *options) {
+		o.orderFallback = columns
+	}
+}
+
+// WithTimeCursor pages the rows by the time column with the after and before params instead of the page,
+// e.g. `after=2024-05-01T12:00:00Z&page_size=50` for the rows after the time, oldest first, and `before=`
+// for the ones before it, newest first. The ties are ordered by the primary key, the client could add the
+// primary key of the last row to the cursor, e.g. `after=2024-05-01T12:00:00Z,42`, not to skip the rows
+// with the equal times. The params are only parsed with the pagination enabled.
+func WithTimeCursor(column string) Option {
+	return func(o *options) {
+		o.timeCursor = column
+	}
+}
+
+// WithFilterCombinator sets the predicate joining the filter params, And by default. The conditions of every
+// param are still ANDed, and the clients could choose it with filter_logic=and|or.
+// Example:
+//
+//	// the rows matching any of the params, e.g. filter=login:bob&filter=email:bob@example.com
+//	filter.FilterByQuery(c, filter.FILTER, filter.WithFilterCombinator(filter.Or))
+func WithFilterCombinator(combinator Combinator) Option {
+	return func(o *options) {
+		o.filterOr = combinator == Or
+	}
+}
+
+// WithCaseSensitiveParams matches the param names of the filters, the orders and the selected fields exactly,
+// for the APIs relying on the casing. They're matched case-insensitively by default, e.g. `filter=Login:bob`.
+func WithCaseSensitiveParams() Option {
+	return func(o *options) {
+		o.caseSensitive = true
+	}
+}
+
+// paramMatches reports whether the param of the request matches the declared one.
+func (o *options) paramMatches(declared, param string) bool {
+	return declared == param || !o.caseSensitive && strings.EqualFold(declared, param)
+}
+
+// WithLikeEscape sets the escape character of the LIKE wildcards in the search phrase and
+// the `~` filter values, `\` by default. The `ESCAPE` clause is added to the expressions with
+// escaped wildcards.
+func WithLikeEscape(escape rune) Option {
+	return func(o *options) {
+		o.likeEscape = escape
+	}
+}
+
+// WithRawLike passes the `~` filter values to LIKE verbatim, so the clients could send their own
+// `%` and `_` wildcards. The search phrase is still escaped.
+func WithRawLike() Option {
+	return func(o *options) {
+		o.rawLike = true
+	}
+}
+
+// WithInsensitiveLike makes the `~` filters case-insensitive, built like the search: ILIKE for postgres and
+// LOWER(column) LIKE for the other dialects. Fields tagged as `searchable:cs` still match case-sensitively.
+func WithInsensitiveLike() Option {
+	return func(o *options) {
+		o.insensitiveLike = true
+	}
+}
+
+// WithArrayIn binds the IN lists built by the custom operators as the single array parameter on postgres,
+// `column = ANY($1)`, so the long lists don't exhaust the parameter limit or the prepared statement caches.
+// The other dialects still expand the lists.
+func WithArrayIn() Option {
+	return func(o *options) {
+		o.arrayIn = true
+	}
+}
+
+// WithLocation sets the time zone of the periods of the granularity filters, e.g. the month of
+// `created_at@month:2024-05` starts at midnight in the location, UTC by default.
+func WithLocation(location *time.Location) Option {
+	return func(o *options) {
+		o.location = location
+	}
+}
+
+// WithTrimSpace trims the leading and trailing whitespace of the search phrase and of every filter value,
+// e.g. the trailing spaces of the mobile keyboards. The whitespace-only search phrase isn't searched at all.
+func WithTrimSpace() Option {
+	return func(o *options) {
+		o.trimSpace = true
+	}
+}
+
+// WithNullNotEqual includes the NULL rows of the nullable columns in the `!=` conditions, e.g. the users
+// without the status for `status!=archived`, since SQL's <> excludes them. The fields could opt in with
+// the `null_neq` tag instead. The columns are nullable unless they're the primary keys or tagged `not null`.
+func WithNullNotEqual() Option {
+	return func(o *options) {
+		o.nullNotEqual = true
+	}
+}
+
+// WithEmptyPolicy sets the meaning of the empty values of the `:` filters on the string columns, e.g. `email:`
+// matching the users without the email whether it's NULL or empty with EmptyMeansNullOrEmpty. The values are empty
+// after WithTrimSpace, and the other columns and operators aren't affected.
+func WithEmptyPolicy(policy EmptyPolicy) Option {
+	return func(o *options) {
+		o.emptyPolicy = policy
+	}
+}
+
+// WithEqualityConflicts sets the handling of the equality filters on the same column with the different values,
+// e.g. filter=id:1&filter=id:2 the clients usually mean as either of the ids. They're ANDed as is by default.
+func WithEqualityConflicts(policy ConflictPolicy) Option {
+	return func(o *options) {
+		o.conflictPolicy = policy
+	}
+}
+
+// WithMaxLength sets the maximum length in characters of the search phrase and of every filter value,
+// 256 and 128 by default. Longer values are truncated or rejected in the strict mode, a non-positive
+// length disables the limit.
+func WithMaxLength(search, filter int) Option {
+	return func(o *options) {
+		o.maxSearchLength = search
+		o.maxFilterLength = filter
+	}
+}
+
+// WithMaxIDs sets the maximum number of the primary keys listed in the ids param, 100 by default.
+// The longer lists are rejected, a non-positive number disables the limit.
+func WithMaxIDs(max int) Option {
+	return func(o *options) {
+		o.maxIDs = max
+	}
+}
+
+// WithMaxPageSize lowers the maximum page size of the call, 100 by default or the one of the model
+// implementing MaxPageSizer. It never raises the maximum of the model.
+func WithMaxPageSize(size int) Option {
+	return func(o *options) {
+		o.maxPageSize = size
+	}
+}
+
+// WithMaxFuzzyDistance sets the maximum edit distance of the ~? filters on the fuzzy fields, 3 by default.
+// The longer distances are limited to it, or rejected with WithStrict.
+func WithMaxFuzzyDistance(distance int) Option {
+	return func(o *options) {
+		o.maxFuzzyDistance = distance
+	}
+}
+
+// WithFilterHeader accepts the filter phrases from the request header too, e.g. X-Filter, for the filters
+// exceeding the URL length limits. They're merged with the filter params and parsed the same way.
+// It's opt-in, since the headers aren't logged by some proxies.
+// Example:
+//
+//	// X-Filter: login:bob,created_at>=2024-01-01
+//	filter.FilterByQuery(c, filter.FILTER, filter.WithFilterHeader("X-Filter"))
+func WithFilterHeader(header string) Option {
+	return func(o *options) {
+		o.filterHeader = header
+	}
+}
+
+// WithIDsOrder orders the rows listed with the ids param in the order of the list unless the client sets order_by,
+// e.g. ids=7,3,9 returns the row 7 first. The clients could ask for it with order_by=ids without the option.
+func WithIDsOrder() Option {
+	return func(o *options) {
+		o.idsOrder = true
+	}
+}
+
+// WithDistinctCount counts the distinct primary keys of the count statements, e.g. if the caller joins the tables
+// multiplying the rows with the raw SQL. The joins of the has-many and many2many relations by name are detected
+// without it, the other count statements count all the rows.
+func WithDistinctCount() Option {
+	return func(o *options) {
+		o.distinctCount = true
+	}
+}
+
+// WithAllowAll sets the callback deciding whether all=true is honored for the request, e.g. only for the service
+// accounts. The denied requests are paginated as usual, or rejected with the 403 *Error with WithStrict.
+// The gin context is nil for the adapters of the other frameworks.
+// Example:
+//
+//	filter.WithAllowAll(func(c *gin.Context) bool {
+//		return c.GetString("role") == "service"
+//	})
+func WithAllowAll(allow func(c *gin.Context) bool) Option {
+	return func(o *options) {
+		o.allowAll = allow
+	}
+}
+
+// WithFieldPolicy sets the policy granting the capabilities of the fields per request, e.g. by the role of
+// the user. The capabilities the field isn't configured with can't be granted, the denied fields are treated
+// as the ones without the capabilities: ignored, or rejected with WithStrict. The fields the policy doesn't
+// grant CapabilitySort can't be ordered by even if they aren't tagged as sortable.
+// The gin context is nil for the adapters of the other frameworks.
+// Example:
+//
+//	filter.WithFieldPolicy(func(c *gin.Context, field filter.FieldMeta) filter.Capability {
+//		if field.Name == "Salary" && c.GetString("role") != "hr" {
+//			return 0
+//		}
+//		return filter.CapabilityAll
+//	})
+func WithFieldPolicy(policy func(c *gin.Context, field FieldMeta) Capability) Option {
+	return func(o *options) {
+		o.fieldPolicy = policy
+	}
+}
+
+// WithAuditHook adds the hook called once per request with the copy of the parsed query, the conditions
+// resolved against the model fields, e.g. to record who searched for what. Several hooks are called in
+// the order they're added. The gin context is nil for the adapters of the other frameworks.
+func WithAuditHook(hook func(c *gin.Context, query Query)) Option {
+	return func(o *options) {
+		previous := o.auditHook
+		if previous == nil {
+			o.auditHook = hook
+			return
+		}
+		o.auditHook = func(c *gin.Context, query Query) {
+			previous(c, query.clone())
+			hook(c, query)
+		}
+	}
+}
+
+// WithSafeWrite makes the scope safe for the bulk Update and Delete statements. The order, pagination,
+// selection and soft-deleted rows params are ignored, and the statements without filter conditions
+// are rejected with the *Error.
+// Example:
+//
+//	db.Model(&User{}).Scopes(filter.FilterByQuery(c, filter.FILTER, filter.WithSafeWrite())).Delete(&User{})
+func WithSafeWrite() Option {
+	return func(o *options) {
+		o.safeWrite = true
+	}
+}
+
+// WithRequired requires the filter conditions on all the params, e.g. a date range for the huge tables.
+// The requests without them are rejected with the *Error regardless of the strict mode.
+func WithRequired(params ...string) Option {
+	return func(o *options) {
+		o.required = append(o.required, params...)
+	}
+}
+
+// WithDebugLogger sets the logger of the filter conditions, called for every condition of the filter params
+// with the param, the column, the operator and the value if it's applied, or the reason if it's skipped.
+func WithDebugLogger(logger func(msg string, fields map[string]interface{})) Option {
+	return func(o *options) {
+		o.debugLogger = logger
+	}
+}
+
+// WithStrict rejects the query parameters which are ignored otherwise, e.g. filters without
+// a filterable field matching, with the *Error added to the DB error.
+func WithStrict() Option {
+	return func(o *options) {
+		o.strict = true
+	}
+}
+
+// WithErrorHandler sets the handler transforming the errors of malformed or rejected query parameters
+// before they're added to the DB error, e.g. to the HTTP errors of the framework.
+func WithErrorHandler(handler func(err error) error) Option {
+	return func(o *options) {
+		o.errorHandler = handler
+	}
+}
+
+func withoutConditions() Option {
+	return func(o *options) {
+		o.skipConditions = true
+	}
+}
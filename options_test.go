@@ -0,0 +1,666 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"database/sql/driver"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func tenantConditions(c *gin.Context) []clause.Expression {
+	return []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: "organization_id"}, Value: c.GetInt("tenant")},
+	}
+}
+
+// TestForcedConditions is a test for forced conditions combined with the client filters.
+func (s *TestSuite) TestForcedConditions() {
+	var users []User
+	ctx := gin.Context{}
+	ctx.Set("tenant", 7)
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=login:sampleUser",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1 AND "users"."organization_id" = \$2$`).
+		WithArgs("sampleUser", 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, FILTER, WithForcedConditions(tenantConditions))).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestForcedConditionsWithoutFilters is a test for forced conditions when search and filter are off.
+func (s *TestSuite) TestForcedConditionsWithoutFilters() {
+	var users []User
+	ctx := gin.Context{}
+	ctx.Set("tenant", 7)
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=login:sampleUser",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."organization_id" = \$1 LIMIT \$2$`).
+		WithArgs(7, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, PAGINATE, WithForcedConditions(tenantConditions))).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestForcedConditionsPlugin is a test for forced conditions applied once through the plugin.
+func (s *TestSuite) TestForcedConditionsPlugin() {
+	s.Require().NoError(s.db.Use(NewPlugin(Settings{
+		Config:  ALL,
+		Options: []Option{WithForcedConditions(tenantConditions)},
+	})))
+
+	var (
+		users []User
+		count int64
+	)
+	ctx := gin.Context{}
+	ctx.Set("tenant", 7)
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "search=John",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT count\(\*\) FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) AND "users"."organization_id" = \$3$`).
+		WithArgs("%john%", "%john%", 7).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) AND "users"."organization_id" = \$3 ORDER BY "id" DESC LIMIT \$4$`).
+		WithArgs("%john%", "%john%", 7, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(UseContext(&ctx)).Count(&count).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestTableFilters is a test for filtering db.Table queries without a model.
+func (s *TestSuite) TestTableFilters() {
+	var stats []struct {
+		Username string
+		Visits   int
+	}
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=login:sampleUser&filter=visits_count>=10&search=samp&order_by=visits_count",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "user_stats" WHERE "user_stats"."username" ILIKE \$1 AND \("user_stats"."username" = \$2 AND "user_stats"."visits_count" >= \$3\) ORDER BY "visits_count" DESC LIMIT \$4$`).
+		WithArgs("%samp%", "sampleUser", "10", 10).
+		WillReturnRows(sqlmock.NewRows([]string{"username", "visits"}))
+	err := s.db.Table("user_stats").Scopes(FilterByQuery(&ctx, ALL, WithTable("user_stats",
+		Field{Name: "Username", Param: "login", Filterable: true, Searchable: true},
+		Field{Name: "Visits", Column: "visits_count", Filterable: true},
+	))).Find(&stats).Error
+	s.NoError(err)
+}
+
+// TestExpressionHook is a test for rewriting the generated expressions with the hook.
+func (s *TestSuite) TestExpressionHook() {
+	var (
+		users []User
+		kinds []Kind
+	)
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=login:sampleUser&filter=id>5&search=John",
+		},
+	}
+	hook := WithExpressionHook(func(kind Kind, exprs []clause.Expression) []clause.Expression {
+		kinds = append(kinds, kind)
+		if kind == KindFilter && len(exprs) == 1 {
+			if _, ok := exprs[0].(clause.Gt); ok {
+				return nil
+			}
+		}
+		return []clause.Expression{clause.Not(exprs...)}
+	})
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" NOT ILIKE \$1 AND "users"."full_name" NOT ILIKE \$2\) AND "users"."username" <> \$3$`).
+		WithArgs("%john%", "%john%", "sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, FILTER|SEARCH, hook)).Find(&users).Error
+	s.NoError(err)
+	s.Equal([]Kind{KindSearch, KindFilter, KindFilter}, kinds)
+}
+
+// Article is a model with the search case sensitivity tags.
+type Article struct {
+	Id    uint
+	Title string `filter:"searchable:ci"`
+	Body  string `filter:"searchable"`
+	Code  string `filter:"searchable:cs"`
+}
+
+// TestPlainSearch is a test for the search without LOWER().
+func (s *TestSuite) TestPlainSearch() {
+	var users []User
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "search=John",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" LIKE \$1 OR "users"."full_name" LIKE \$2\)$`).
+		WithArgs("%John%", "%John%").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, SEARCH, WithPlainSearch())).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestSearchCombinator is a test for the search matching all the searchable fields.
+func (s *TestSuite) TestSearchCombinator() {
+	var users []User
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "search=John&filter=email:john@example.com",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 AND "users"."full_name" ILIKE \$2\) `+
+		`AND "users"."email" = \$3$`).
+		WithArgs("%john%", "%john%", "john@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, SEARCH|FILTER, WithSearchCombinator(And))).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestIDSearch is a test for the integer search phrases matching the primary key.
+func (s *TestSuite) TestIDSearch() {
+	tests := []struct {
+		search   string
+		expected string
+		args     []driver.Value
+	}{
+		{"42", `^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2 OR "users"."id" = \$3\)$`,
+			[]driver.Value{"%42%", "%42%", int64(42)}},
+		{"42abc", `^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\)$`,
+			[]driver.Value{"%42abc%", "%42abc%"}},
+	}
+
+	for _, test := range tests {
+		s.Run(test.search, func() {
+			var users []User
+			ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: "search=" + test.search}}}
+			s.mock.ExpectQuery(test.expected).
+				WithArgs(test.args...).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+			err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, SEARCH, WithIDSearch())).Find(&users).Error
+			s.NoError(err)
+		})
+	}
+}
+
+// TestRelevanceOrder is a test for ordering the searched rows by the relevance unless they're ordered explicitly.
+func (s *TestSuite) TestRelevanceOrder() {
+	tests := []struct {
+		query    string
+		expected string
+		args     []driver.Value
+	}{
+		{"search=John", `^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) ` +
+			`ORDER BY CASE WHEN \("users"."username" ILIKE \$3 OR "users"."full_name" ILIKE \$4\) THEN 0 ` +
+			`WHEN \("users"."username" ILIKE \$5 OR "users"."full_name" ILIKE \$6\) THEN 1 ELSE 2 END, "id" DESC$`,
+			[]driver.Value{"%john%", "%john%", "john%", "john%", "%john%", "%john%"}},
+		{"search=John&order_by=email", `^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) ` +
+			`ORDER BY "email" DESC$`,
+			[]driver.Value{"%john%", "%john%"}},
+		{"", `^SELECT \* FROM "users" ORDER BY "id" DESC$`, nil},
+	}
+
+	for _, test := range tests {
+		s.Run(test.query, func() {
+			var users []User
+			ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: test.query}}}
+			s.mock.ExpectQuery(test.expected).
+				WithArgs(test.args...).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+			err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, SEARCH|ORDER_BY, WithRelevanceOrder())).Find(&users).Error
+			s.NoError(err)
+		})
+	}
+}
+
+type Release struct {
+	Id        uint
+	Version   string `filter:"param:version;sortable"`
+	Headline  string `filter:"param:title;sortable"`
+	Notes     string
+	CreatedAt time.Time
+}
+
+// TestOrderFallback is a test for ordering by the fallback column instead of the invalid requested one.
+func (s *TestSuite) TestOrderFallback() {
+	tests := []struct {
+		query    string
+		expected string
+		fallback bool
+	}{
+		{"order_by=version", `ORDER BY "version" DESC`, false},
+		{"order_by=notes", `ORDER BY "created_at" DESC`, true},
+		{"order_by=unknown&order_direction=asc", `ORDER BY "created_at"`, true},
+		{"order_by=version,notes", `ORDER BY "version" DESC`, false},
+		{"order_by=title&order_direction=asc", `ORDER BY "headline"`, false},
+		{"order_by=headline,title", `ORDER BY "headline" DESC,"headline" DESC`, false},
+		{"", `ORDER BY "id" DESC`, false},
+	}
+	for _, test := range tests {
+		var releases []Release
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/releases?"+test.query, nil)}
+		s.mock.ExpectQuery(`^SELECT \* FROM "releases" ` + test.expected + `$`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "version", "headline", "notes", "created_at"}))
+		scope, meta := ParseAndScope(&ctx, ORDER_BY, WithOrderFallback("published_at", "created_at"))
+		err := s.db.Model(&Release{}).Scopes(scope).Find(&releases).Error
+		s.NoError(err, test.query)
+		s.Equal(test.fallback, meta.OrderFallback, test.query)
+	}
+
+	// The primary key is the last fallback.
+	var releases []Release
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/releases?order_by=notes", nil)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "releases" ORDER BY "id" DESC$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "version", "headline", "notes", "created_at"}))
+	scope, meta := ParseAndScope(&ctx, ORDER_BY, WithOrderFallback("published_at"))
+	s.NoError(s.db.Model(&Release{}).Scopes(scope).Find(&releases).Error)
+	s.Equal("id", meta.OrderBy)
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	// The invalid columns are rejected in the strict mode.
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/releases?order_by=version,notes", nil)
+	err := s.db.Model(&Release{}).Scopes(FilterByQuery(c, ORDER_BY, WithOrderFallback("created_at"), WithStrict())).Find(&releases).Error
+	var filterErr *Error
+	s.True(errors.As(err, &filterErr))
+	s.Equal(&Error{Param: "order_by", Value: "notes", Reason: "not sortable"}, filterErr)
+}
+
+type Ticket struct {
+	Id     uint
+	Number int    `filter:"searchable:id"`
+	Title  string `filter:"searchable"`
+}
+
+// TestIDSearchTag is a test for the integer search phrases matching the fields tagged as searchable:id.
+func (s *TestSuite) TestIDSearchTag() {
+	var tickets []Ticket
+	ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: "search=1234"}}}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "tickets" WHERE \("tickets"."title" ILIKE \$1 OR "tickets"."number" = \$2\)$`).
+		WithArgs("%1234%", int64(1234)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "number", "title"}))
+	err := s.db.Model(&Ticket{}).Scopes(FilterByQuery(&ctx, SEARCH, WithIDSearch())).Find(&tickets).Error
+	s.NoError(err)
+}
+
+// TestSearchCaseTags is a test for the field case sensitivity tags overriding the search option.
+func (s *TestSuite) TestSearchCaseTags() {
+	var articles []Article
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "search=John",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "articles" WHERE \("articles"."title" ILIKE \$1 OR "articles"."body" ILIKE \$2 OR "articles"."code" LIKE \$3\)$`).
+		WithArgs("%john%", "%john%", "%John%").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "body", "code"}))
+	err := s.db.Model(&Article{}).Scopes(FilterByQuery(&ctx, SEARCH)).Find(&articles).Error
+	s.NoError(err)
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "articles" WHERE \("articles"."title" ILIKE \$1 OR "articles"."body" LIKE \$2 OR "articles"."code" LIKE \$3\)$`).
+		WithArgs("%john%", "%John%", "%John%").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "body", "code"}))
+	err = s.db.Model(&Article{}).Scopes(FilterByQuery(&ctx, SEARCH, WithPlainSearch())).Find(&articles).Error
+	s.NoError(err)
+}
+
+// TestSearchCaseParam is a test for the search case requested by the client for the fields without the case tags.
+func (s *TestSuite) TestSearchCaseParam() {
+	tests := []struct {
+		query    string
+		opts     []Option
+		expected string
+		args     []driver.Value
+	}{
+		{"search=John&search_case=sensitive", nil,
+			`"articles"."title" ILIKE \$1 OR "articles"."body" LIKE \$2 OR "articles"."code" LIKE \$3`,
+			[]driver.Value{"%john%", "%John%", "%John%"}},
+		{"search=John&search_case=insensitive", nil,
+			`"articles"."title" ILIKE \$1 OR "articles"."body" ILIKE \$2 OR "articles"."code" LIKE \$3`,
+			[]driver.Value{"%john%", "%john%", "%John%"}},
+		{"search=John&search_case=insensitive", []Option{WithPlainSearch()},
+			`"articles"."title" ILIKE \$1 OR "articles"."body" ILIKE \$2 OR "articles"."code" LIKE \$3`,
+			[]driver.Value{"%john%", "%john%", "%John%"}},
+		{"search=John&search_case=unknown", nil,
+			`"articles"."title" ILIKE \$1 OR "articles"."body" ILIKE \$2 OR "articles"."code" LIKE \$3`,
+			[]driver.Value{"%john%", "%john%", "%John%"}},
+	}
+	for _, test := range tests {
+		var articles []Article
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/articles?"+test.query, nil)}
+		s.mock.ExpectQuery(`^SELECT \* FROM "articles" WHERE \(` + test.expected + `\)$`).
+			WithArgs(test.args...).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "title", "body", "code"}))
+		err := s.db.Model(&Article{}).Scopes(FilterByQuery(&ctx, SEARCH, test.opts...)).Find(&articles).Error
+		s.NoError(err, test.query)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	_, err := ParseQuery(url.Values{"search": {"John"}, "search_case": {"upper"}}, &Article{}, SEARCH, WithStrict())
+	s.Equal(&Error{Param: "search_case", Value: "upper", Reason: "must be sensitive or insensitive"}, err)
+}
+
+type Contact struct {
+	Id    uint
+	Email string `gorm:"type:citext" filter:"searchable"`
+	Name  string `filter:"searchable"`
+}
+
+// TestSearchCIText is a test for the plain LIKE search of the citext columns.
+func (s *TestSuite) TestSearchCIText() {
+	var contacts []Contact
+	ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: "search=John"}}}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "contacts" WHERE \("contacts"."email" LIKE \$1 OR "contacts"."name" ILIKE \$2\)$`).
+		WithArgs("%john%", "%john%").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "name"}))
+	err := s.db.Model(&Contact{}).Scopes(FilterByQuery(&ctx, SEARCH)).Find(&contacts).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+// TestAuditHook is a test for the hook called with the copy of the applied query.
+func (s *TestSuite) TestAuditHook() {
+	var (
+		users   []User
+		audited []Query
+	)
+	ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: "filter=login:sampleUser&filter=email~example&search=John&page=2"}}}
+	hook := func(c *gin.Context, query Query) {
+		s.Equal(&ctx, c)
+		audited = append(audited, query)
+		query.Filters[0].Value = "admin"
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) `+
+		`AND \("users"."username" = \$3 AND "users"."email" LIKE \$4\) ORDER BY "id" DESC LIMIT \$5 OFFSET \$6$`).
+		WithArgs("%john%", "%john%", "sampleUser", "example", 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	// The hooks are called in order, each one with its own copy.
+	scope, meta := ParseAndScope(&ctx, ALL, WithAuditHook(hook), WithAuditHook(func(c *gin.Context, query Query) {
+		audited = append(audited, query)
+	}))
+	err := s.db.Model(&User{}).Scopes(scope).Find(&users).Error
+	s.NoError(err)
+	s.Equal("sampleUser", meta.AppliedFilters[0].Value)
+
+	s.Require().Len(audited, 2)
+	s.Equal("sampleUser", audited[1].Filters[0].Value)
+	s.Equal([]Condition{
+		{Field: "Username", Param: "login", Column: "username", Operator: ":", Value: "admin"},
+		{Field: "Email", Param: "email", Column: "email", Operator: "~", Value: "example", phrase: 1},
+	}, audited[0].Filters)
+	s.Equal("John", audited[0].Search)
+	s.Equal(2, audited[0].Page)
+	s.Equal(10, audited[0].PageSize)
+}
+
+// TestSafeWrite is a test for the scope of the bulk Update and Delete statements.
+func (s *TestSuite) TestSafeWrite() {
+	ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: "filter=login:sampleUser&page=2&all=true&order_by=email&fields=email"}}}
+	dryRun := s.db.Session(&gorm.Session{DryRun: true, SkipDefaultTransaction: true})
+
+	statement := dryRun.Model(&User{}).Scopes(FilterByQuery(&ctx, ALL, WithSafeWrite())).Delete(&User{}).Statement
+	s.Equal(`DELETE FROM "users" WHERE "users"."username" = $1`, statement.SQL.String())
+
+	statement = dryRun.Model(&User{}).Scopes(FilterByQueryConfig(&ctx, Config{Filter: true, Paginate: true, Fields: true}, WithSafeWrite())).
+		Updates(map[string]interface{}{"full_name": "John", "email": "john@example.com"}).Statement
+	s.Equal(`UPDATE "users" SET "email"=$1,"full_name"=$2 WHERE "users"."username" = $3`, statement.SQL.String())
+}
+
+// TestSafeWriteWithoutFilters is a test for rejecting the bulk Delete statements without filter conditions.
+func (s *TestSuite) TestSafeWriteWithoutFilters() {
+	tenant := WithForcedConditions(func(*gin.Context) []clause.Expression {
+		return []clause.Expression{clause.Eq{Column: "organization_id", Value: 1}}
+	})
+	for _, query := range []string{"page=2", "filter=password:secret", "search=John"} {
+		values, _ := url.ParseQuery(query)
+		err := s.db.Session(&gorm.Session{SkipDefaultTransaction: true}).Model(&User{}).
+			Scopes(FilterByValues(values, ALL, WithSafeWrite(), tenant)).Delete(&User{}).Error
+		var filterErr *Error
+		s.Require().True(errors.As(err, &filterErr), query)
+		s.Equal("filter", filterErr.Param)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+type OrganizationStats struct {
+	OrganizationId uint `filter:"param:organization_id;filterable"`
+	UserCount      int  `filter:"param:user_count;filterable;having:COUNT(*)"`
+	AdminCount     int  `filter:"param:admins;filterable;having"`
+}
+
+// TestHaving is a test for the conditions of the aggregates applied in HAVING.
+func (s *TestSuite) TestHaving() {
+	var stats []OrganizationStats
+	ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: "filter=user_count>=5&filter=organization_id:3&filter=admins>1"}}}
+
+	s.mock.ExpectQuery(`^SELECT organization_id, COUNT\(\*\) AS user_count FROM "users" WHERE "users"."organization_id" = \$1 `+
+		`GROUP BY "organization_id" HAVING COUNT\(\*\) >= \$2 AND "admin_count" > \$3$`).
+		WithArgs("3", "5", "1").
+		WillReturnRows(sqlmock.NewRows([]string{"organization_id", "user_count"}))
+	err := s.db.Model(&OrganizationStats{}).Table("users").Select("organization_id, COUNT(*) AS user_count").Group("organization_id").
+		Scopes(FilterByQuery(&ctx, FILTER)).Find(&stats).Error
+	s.NoError(err)
+}
+
+// TestRequired is a test for the required filter conditions.
+func (s *TestSuite) TestRequired() {
+	tests := []struct {
+		query string
+		valid bool
+	}{
+		{"filter=email~example&filter=id>=10", true},
+		{"filter=email~example,id>=10", true},
+		{"filter=email~example", false},
+		{"filter=login:sampleUser&filter=id>=10", false},
+		{"filter=id>=10&filter=password:secret", false},
+		{"search=John", false},
+	}
+
+	for _, test := range tests {
+		s.Run(test.query, func() {
+			var users []User
+			values, _ := url.ParseQuery(test.query)
+			err := s.db.Session(&gorm.Session{DryRun: true}).Model(&User{}).
+				Scopes(FilterByValues(values, ALL, WithRequired("email", "id"))).Find(&users).Error
+			if test.valid {
+				s.NoError(err)
+				return
+			}
+			var filterErr *Error
+			s.Require().True(errors.As(err, &filterErr))
+			s.Equal("filter", filterErr.Param)
+		})
+	}
+}
+
+// TestDebugLogger is a test for logging the applied and the skipped filter conditions.
+func (s *TestSuite) TestDebugLogger() {
+	type entry struct {
+		msg    string
+		fields map[string]interface{}
+	}
+	var entries []entry
+	logger := func(msg string, fields map[string]interface{}) {
+		entries = append(entries, entry{msg, fields})
+	}
+
+	var users []User
+	values := url.Values{"filter": {"login:sampleUser", "password:secret"}}
+	err := s.db.Session(&gorm.Session{DryRun: true}).Model(&User{}).
+		Scopes(FilterByValues(values, FILTER, WithDebugLogger(logger))).Find(&users).Error
+	s.NoError(err)
+	s.Equal([]entry{
+		{"filter condition applied", map[string]interface{}{
+			"phrase": "login:sampleUser", "param": "login", "column": "username", "operator": ":", "value": "sampleUser",
+		}},
+		{"filter condition skipped", map[string]interface{}{
+			"phrase": "password:secret", "param": "password", "operator": ":", "value": "secret", "reason": "field is not filterable",
+		}},
+	}, entries)
+}
+
+// TestFilterHeader is a test for merging the filter header with the filter params.
+func (s *TestSuite) TestFilterHeader() {
+	var users []User
+	tests := []struct {
+		target, header, expected string
+		args                     []driver.Value
+	}{
+		{"/users", "login:sampleUser,email:john@example.com",
+			`^SELECT \* FROM "users" WHERE "users"."username" = \$1 AND "users"."email" = \$2$`,
+			[]driver.Value{"sampleUser", "john@example.com"}},
+		{"/users?filter=login:sampleUser", "",
+			`^SELECT \* FROM "users" WHERE "users"."username" = \$1$`,
+			[]driver.Value{"sampleUser"}},
+		{"/users?filter=login:sampleUser", "email:john@example.com,password:secret",
+			`^SELECT \* FROM "users" WHERE "users"."username" = \$1 AND "users"."email" = \$2$`,
+			[]driver.Value{"sampleUser", "john@example.com"}},
+	}
+
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodGet, test.target, nil)
+		if test.header != "" {
+			r.Header.Set("X-Filter", test.header)
+		}
+		s.mock.ExpectQuery(test.expected).
+			WithArgs(test.args...).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+		ctx := gin.Context{Request: r}
+		err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, FILTER, WithFilterHeader("X-Filter"))).Find(&users).Error
+		s.NoError(err)
+	}
+
+	// The header is ignored unless it's configured.
+	r := httptest.NewRequest(http.MethodGet, "/users", nil)
+	r.Header.Set("X-Filter", "login:sampleUser")
+	s.mock.ExpectQuery(`^SELECT \* FROM "users"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	s.NoError(s.db.Model(&User{}).Scopes(FilterByRequest(r, FILTER)).Find(&users).Error)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+// TestTrimSpace is a test for trimming the whitespace around the search phrase and the filter values.
+func (s *TestSuite) TestTrimSpace() {
+	var users []User
+	values := url.Values{"search": {"\tJohn\t"}, "filter": {"login:sampleUser "}}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) `+
+		`AND "users"."username" = \$3$`).
+		WithArgs("%john%", "%john%", "sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByValues(values, SEARCH|FILTER, WithTrimSpace())).Find(&users).Error
+	s.NoError(err)
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) `+
+		`AND "users"."username" = \$3$`).
+		WithArgs("%\tjohn\t%", "%\tjohn\t%", "sampleUser ").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err = s.db.Model(&User{}).Scopes(FilterByValues(values, SEARCH|FILTER)).Find(&users).Error
+	s.NoError(err)
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err = s.db.Model(&User{}).Scopes(FilterByValues(url.Values{"search": {" \n"}}, SEARCH, WithTrimSpace())).Find(&users).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+type Shipment struct {
+	Id      uint    `filter:"param:id;filterable"`
+	Status  *string `filter:"filterable"`
+	Carrier string  `gorm:"not null" filter:"filterable"`
+	State   string  `filter:"filterable;null_neq"`
+}
+
+// TestNullNotEqual is a test for including the NULL rows of the nullable columns in the != conditions.
+func (s *TestSuite) TestNullNotEqual() {
+	tests := []struct {
+		filter   string
+		options  []Option
+		expected string
+	}{
+		{"status!=archived", nil, `"shipments"."status" <> \$1`},
+		{"status!=archived", []Option{WithNullNotEqual()}, `\("shipments"."status" <> \$1 OR "shipments"."status" IS NULL\)`},
+		{"carrier!=archived", []Option{WithNullNotEqual()}, `"shipments"."carrier" <> \$1`},
+		{"id!=archived", []Option{WithNullNotEqual()}, `"shipments"."id" <> \$1`},
+		{"state!=archived", nil, `\("shipments"."state" <> \$1 OR "shipments"."state" IS NULL\)`},
+	}
+	for _, test := range tests {
+		var shipments []Shipment
+		ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: "filter=" + test.filter}}}
+		s.mock.ExpectQuery(`^SELECT \* FROM "shipments" WHERE ` + test.expected + `$`).
+			WithArgs("archived").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "status", "carrier", "state"}))
+		err := s.db.Model(&Shipment{}).Scopes(FilterByQuery(&ctx, FILTER, test.options...)).Find(&shipments).Error
+		s.NoError(err, test.filter)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+// TestEmptyPolicy is a test for the meaning of the empty values of the equality filters on the string columns.
+func (s *TestSuite) TestEmptyPolicy() {
+	tests := []struct {
+		filter   string
+		options  []Option
+		expected string
+	}{
+		{"status:", nil, `"shipments"."status" = \$1`},
+		{"status:", []Option{WithEmptyPolicy(EmptyMeansEmptyString)}, `"shipments"."status" = \$1`},
+		{"status:", []Option{WithEmptyPolicy(EmptyMeansNullOrEmpty)}, `\("shipments"."status" = \$1 OR "shipments"."status" IS NULL\)`},
+		{"status:%20", []Option{WithEmptyPolicy(EmptyMeansNullOrEmpty), WithTrimSpace()}, `\("shipments"."status" = \$1 OR "shipments"."status" IS NULL\)`},
+		{"carrier:", []Option{WithEmptyPolicy(EmptyMeansNullOrEmpty)}, `"shipments"."carrier" = \$1`},
+		{"status!=", []Option{WithEmptyPolicy(EmptyMeansNullOrEmpty)}, `"shipments"."status" <> \$1`},
+	}
+	for _, test := range tests {
+		var shipments []Shipment
+		ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: "filter=" + test.filter}}}
+		s.mock.ExpectQuery(`^SELECT \* FROM "shipments" WHERE ` + test.expected + `$`).
+			WithArgs("").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "status", "carrier", "state"}))
+		err := s.db.Model(&Shipment{}).Scopes(FilterByQuery(&ctx, FILTER, test.options...)).Find(&shipments).Error
+		s.NoError(err, test.filter)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	var shipments []Shipment
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/shipments?filter=status:", nil)
+	err := s.db.Session(&gorm.Session{DryRun: true}).Model(&Shipment{}).
+		Scopes(FilterByQuery(ctx, FILTER, WithEmptyPolicy(EmptyRejected))).Find(&shipments).Error
+	s.EqualError(err, `filter: invalid filter "status:": value must not be empty`)
+	s.Equal(http.StatusBadRequest, ctx.Writer.Status())
+}
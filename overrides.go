@@ -0,0 +1,41 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// CtxExtraFilters is the gin context key of the []string filter params added by the server,
+	// e.g. by the row-level security middleware. They're ANDed with the query ones even if FILTER isn't set.
+	CtxExtraFilters = "filter:extra_filters"
+	// CtxOverridePageSize is the gin context key of the int page size preferred over the query one.
+	CtxOverridePageSize = "filter:page_size"
+)
+
+// overrideQuery merges the query with the filters and the overrides set in the gin context.
+func overrideQuery(c *gin.Context, query *Query, config Config) error {
+	if value, ok := c.Get(CtxExtraFilters); ok {
+		filters, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("filter: %s must be []string, got %T", CtxExtraFilters, value)
+		}
+		query.filter = append(query.filter[:len(query.filter):len(query.filter)], filters...)
+	}
+	if value, ok := c.Get(CtxOverridePageSize); ok && config.Paginate {
+		pageSize, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("filter: %s must be int, got %T", CtxOverridePageSize, value)
+		}
+		params := queryParams{Page: query.Page, PageSize: pageSize}
+		normalizePagination(&params)
+		query.PageSize, query.pageSize = params.PageSize, max(pageSize, 0)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestContextOverrides is a test for the filters and the page size set in the gin context by the middleware.
+func (s *TestSuite) TestContextOverrides() {
+	var users []User
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set(CtxExtraFilters, []string{"email:john@example.com"})
+		c.Set(CtxOverridePageSize, 5)
+	})
+	router.GET("/users", func(c *gin.Context) {
+		err := s.db.Model(&User{}).Scopes(FilterByQuery(c, ALL)).Find(&users).Error
+		s.NoError(err)
+	})
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1 AND "users"."email" = \$2 ORDER BY "id" DESC LIMIT \$3$`).
+		WithArgs("sampleUser", "john@example.com", 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users?filter=login:sampleUser&page_size=50", nil))
+}
+
+// TestContextExtraFiltersWithoutFilterConfig is a test for applying the extra filters without FILTER set.
+func (s *TestSuite) TestContextExtraFiltersWithoutFilterConfig() {
+	var users []User
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/users?filter=login:sampleUser", nil)
+	ctx.Set(CtxExtraFilters, []string{"email:john@example.com"})
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."email" = \$1$`).
+		WithArgs("john@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(ctx, SEARCH)).Find(&users).Error
+	s.NoError(err)
+
+	ctx.Set(CtxOverridePageSize, "5")
+	err = s.db.Model(&User{}).Scopes(FilterByQuery(ctx, ALL)).Find(&users).Error
+	s.Error(err)
+	s.False(ctx.IsAborted())
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import "reflect"
+
+// MaxPageSizer is implemented by the models with their own maximum page size, replacing the default one of 100.
+// The page sizes above it are limited to it, WithMaxPageSize could only lower it further.
+// Example:
+//
+//	func (AuditLog) MaxPageSize() int { return 1000 }
+type MaxPageSizer interface {
+	MaxPageSize() int
+}
+
+var maxPageSizerType = reflect.TypeOf((*MaxPageSizer)(nil)).Elem()
+
+// pageSizeLimit returns the maximum page size of the model with the options. The model isn't introspected,
+// so the pagination alone doesn't parse its schema.
+func pageSizeLimit(model interface{}, o *options) int {
+	limit := maxPageSize
+	if modelType := structType(model); modelType != nil && reflect.PointerTo(modelType).Implements(maxPageSizerType) {
+		if size := reflect.New(modelType).Interface().(MaxPageSizer).MaxPageSize(); size > 0 {
+			limit = size
+		}
+	}
+	if o.maxPageSize > 0 && o.maxPageSize < limit {
+		limit = o.maxPageSize
+	}
+	return limit
+}
+
+// limitPageSize limits the requested page size to the maximum of the model with the options.
+func limitPageSize(model interface{}, query *Query, o *options) {
+	query.PageSize = min(max(query.PageSize, query.pageSize), pageSizeLimit(model, o))
+}
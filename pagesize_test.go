@@ -0,0 +1,69 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+type LogEntry struct {
+	Id      uint
+	Message string `filter:"filterable"`
+}
+
+func (LogEntry) MaxPageSize() int { return 1000 }
+
+type SearchRow struct {
+	Id    uint
+	Title string `filter:"searchable"`
+}
+
+func (*SearchRow) MaxPageSize() int { return 25 }
+
+// TestMaxPageSize is a test for limiting the page size to the maximum of the model.
+func (s *TestSuite) TestMaxPageSize() {
+	tests := []struct {
+		model    interface{}
+		pageSize string
+		opts     []Option
+		expected int
+	}{
+		{&LogEntry{}, "500", nil, 500},
+		{&LogEntry{}, "5000", nil, 1000},
+		{&LogEntry{}, "", nil, defaultPageSize},
+		{&LogEntry{}, "500", []Option{WithMaxPageSize(200)}, 200},
+		{&LogEntry{}, "5000", []Option{WithMaxPageSize(2000)}, 1000},
+		{&SearchRow{}, "50", nil, 25},
+		{&SearchRow{}, "10", nil, 10},
+		{&User{}, "500", nil, maxPageSize},
+		{&User{}, "50", []Option{WithMaxPageSize(20)}, 20},
+	}
+	for _, test := range tests {
+		query, err := ParseQuery(url.Values{"page_size": {test.pageSize}}, test.model, PAGINATE, test.opts...)
+		s.NoError(err)
+		s.Equal(test.expected, query.PageSize, "%T %s", test.model, test.pageSize)
+	}
+
+	var entries []LogEntry
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/entries?page_size=500&page=2", nil)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "log_entries" LIMIT \$1 OFFSET \$2$`).
+		WithArgs(500, 500).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "message"}))
+	scope, meta := ParseAndScope(&ctx, PAGINATE)
+	err := s.db.Model(&LogEntry{}).Scopes(scope).Find(&entries).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+	s.Equal(500, meta.PageSize)
+
+	schema, err := JSONSchema(&SearchRow{}, PAGINATE)
+	s.NoError(err)
+	s.Contains(string(schema), `"maximum": 25`)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestParamCase is a test for matching the param names case-insensitively.
+func (s *TestSuite) TestParamCase() {
+	tests := []struct {
+		query    string
+		config   int
+		opts     []Option
+		expected string
+	}{
+		{"filter=Login:bob", FILTER, nil, `WHERE "users"."username" = \$1`},
+		{"filter=LOGIN:bob,EMAIL:bob@example.com", FILTER, nil, `WHERE "users"."username" = \$1 AND "users"."email" = \$2`},
+		{"order_by=EMAIL,Id&order_direction=asc", ORDER_BY, nil, `ORDER BY "email","id"`},
+		{"order_by=Organization_Id&order_direction=asc", ORDER_BY, nil, `ORDER BY "organization_id"`},
+		{"filter=Login:bob", FILTER, []Option{WithCaseSensitiveParams()}, ``},
+		{"order_by=EMAIL&order_direction=asc", ORDER_BY, []Option{WithCaseSensitiveParams()}, `ORDER BY "EMAIL"`},
+	}
+	for _, test := range tests {
+		var users []User
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?"+test.query, nil)}
+		expected := `^SELECT \* FROM "users"$`
+		if test.expected != "" {
+			expected = `^SELECT \* FROM "users" ` + test.expected + `$`
+		}
+		s.mock.ExpectQuery(expected).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+		err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, test.config, test.opts...)).Find(&users).Error
+		s.NoError(err, test.query)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	// The orders are folded to the columns of the sortable fields.
+	for query, expected := range map[string]string{
+		"order_by=TITLE":            `ORDER BY "headline" DESC`,
+		"order_by=Headline,VERSION": `ORDER BY "headline" DESC,"version" DESC`,
+		"order_by=Notes":            `ORDER BY "notes" DESC`,
+	} {
+		var releases []Release
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/releases?"+query, nil)}
+		s.mock.ExpectQuery(`^SELECT \* FROM "releases" ` + expected + `$`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "version", "headline", "notes", "created_at"}))
+		err := s.db.Model(&Release{}).Scopes(FilterByQuery(&ctx, ORDER_BY)).Find(&releases).Error
+		s.NoError(err, query)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	// The params differing only by case conflict.
+	type Employee struct {
+		Id        uint
+		Code      string `filter:"param:code;filterable"`
+		LegacyKey string `filter:"param:Code;filterable"`
+	}
+	s.EqualError(Validate(&Employee{}), `filter: invalid field Employee.LegacyKey: param "Code" differs only by case from "code" of Code`)
+}
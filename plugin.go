@@ -0,0 +1,94 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const (
+	// ContextKey is the statement setting key the Plugin reads the gin context from.
+	ContextKey = "filter:ctx"
+	appliedKey = "filter:applied"
+	pluginName = "gin-gorm-filter"
+	// conditionsApplied is the internal flag marking search, filter and forced conditions applied.
+	conditionsApplied = 1 << 30
+)
+
+// Settings configures the Plugin.
+type Settings struct {
+	// Config is a combination of SEARCH, FILTER, PAGINATE and ORDER_BY flags.
+	Config int
+	// Options customize the filtering behavior.
+	Options []Option
+}
+
+// Plugin applies query parameters filtering to every query statement that has the gin context set.
+// Example:
+//
+//	db.Use(filter.NewPlugin(filter.Settings{Config: filter.ALL}))
+//	// ...
+//	db.Set(filter.ContextKey, c).Model(&User{}).Find(&users)
+//
+// Or with the scope:
+//
+//	db.Model(&User{}).Scopes(filter.UseContext(c)).Find(&users)
+type Plugin struct {
+	settings Settings
+}
+
+// NewPlugin creates a Plugin with the given settings.
+func NewPlugin(settings Settings) *Plugin {
+	return &Plugin{settings: settings}
+}
+
+// Name implements gorm.Plugin.
+func (p *Plugin) Name() string {
+	return pluginName
+}
+
+// Initialize implements gorm.Plugin, registering the plugin before the query callback.
+func (p *Plugin) Initialize(db *gorm.DB) error {
+	return db.Callback().Query().Before("gorm:query").Register(pluginName+":query", p.query)
+}
+
+// UseContext sets the gin context the Plugin reads query parameters from.
+func UseContext(c *gin.Context) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Set(ContextKey, c)
+	}
+}
+
+func (p *Plugin) query(db *gorm.DB) {
+	value, ok := db.Get(ContextKey)
+	if !ok {
+		return
+	}
+	c, ok := value.(*gin.Context)
+	if !ok || c == nil {
+		return
+	}
+
+	// Chained statements (e.g. Count followed by Find) and preloads share the settings,
+	// so only the flags which weren't applied to the statement yet are applied.
+	applied, _ := db.Get(appliedKey)
+	appliedConfig, _ := applied.(int)
+	config := p.settings.Config &^ appliedConfig
+	if isCount(db) {
+		config &^= PAGINATE | ORDER_BY
+	}
+	opts := p.settings.Options
+	if appliedConfig&conditionsApplied != 0 {
+		if config == 0 {
+			return
+		}
+		opts = append(opts[:len(opts):len(opts)], withoutConditions())
+	}
+
+	db.Statement.Settings.Store(appliedKey, appliedConfig|config|conditionsApplied)
+	FilterByQuery(c, config, opts...)(db)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestPlugin is a test for filtering through the gorm plugin without explicit scopes.
+func (s *TestSuite) TestPlugin() {
+	s.Require().NoError(s.db.Use(NewPlugin(Settings{Config: ALL})))
+
+	var users []User
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=login:sampleUser",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1 ORDER BY "id" DESC LIMIT \$2$`).
+		WithArgs("sampleUser", 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Set(ContextKey, &ctx).Model(&User{}).Find(&users).Error
+	s.NoError(err)
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err = s.db.Model(&User{}).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestPluginCountAndFind is a test for the plugin with count and find chained on one statement.
+func (s *TestSuite) TestPluginCountAndFind() {
+	s.Require().NoError(s.db.Use(NewPlugin(Settings{Config: ALL})))
+
+	var (
+		users []User
+		count int64
+	)
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=login:sampleUser&page=2",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT count\(\*\) FROM "users" WHERE "users"."username" = \$1$`).
+		WithArgs("sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1 ORDER BY "id" DESC LIMIT \$2 OFFSET \$3$`).
+		WithArgs("sampleUser", 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(UseContext(&ctx)).Count(&count).Find(&users).Error
+	s.NoError(err)
+	s.Equal(int64(11), count)
+}
+
+// TestPluginMatchesNothing is a test for the contradictory filters of the plugin statement not sent to the database.
+func (s *TestSuite) TestPluginMatchesNothing() {
+	s.Require().NoError(s.db.Use(NewPlugin(Settings{Config: ALL, Options: []Option{WithEqualityConflicts(ConflictsMatchNothing)}})))
+
+	var (
+		users []User
+		count int64
+	)
+	ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: "filter=id:1&filter=id:2"}}}
+	err := s.db.Model(&User{}).Scopes(UseContext(&ctx)).Count(&count).Find(&users).Error
+	s.NoError(err)
+	s.Zero(count)
+	s.Empty(users)
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	// The other statements are still sent.
+	s.mock.ExpectQuery(`^SELECT \* FROM "users"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	s.NoError(s.db.Model(&User{}).Find(&users).Error)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"slices"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Capability is the set of the field capabilities granted by the field policy, see WithFieldPolicy.
+type Capability uint8
+
+const (
+	CapabilityFilter Capability = 1 << iota // filter by the field
+	CapabilitySearch                        // search the field
+	CapabilitySort                          // order by the field
+	CapabilitySelect                        // select the field with the fields param
+	// CapabilityAll grants all the capabilities the field is configured with.
+	CapabilityAll = CapabilityFilter | CapabilitySearch | CapabilitySort | CapabilitySelect
+)
+
+// forRequest returns the options of the request with the field policy, caching the fields it grants.
+// The options are returned as is without the policy.
+func (o *options) forRequest(c *gin.Context) *options {
+	if o.fieldPolicy == nil {
+		return o
+	}
+	scoped := *o
+	scoped.request, scoped.policyMetas = c, make(map[*modelMeta]*modelMeta, 1)
+	return &scoped
+}
+
+// policyMeta returns the metadata of the model with the capabilities of the fields the policy denies stripped.
+// The fields denied ordering are listed in unsortable, even if they aren't tagged as sortable, since the order
+// columns aren't checked otherwise.
+func (o *options) policyMeta(meta *modelMeta) *modelMeta {
+	if cached, ok := o.policyMetas[meta]; ok {
+		return cached
+	}
+	granted := *meta
+	granted.fields = slices.Clone(meta.fields)
+	granted.unsortable = nil
+	for i := range granted.fields {
+		field := &granted.fields[i]
+		fieldMeta := FieldMeta{Field: field.Field, Type: field.valueType}
+		fieldMeta.Param = field.param
+		capability := o.fieldPolicy(o.request, fieldMeta)
+		field.Filterable = field.Filterable && capability&CapabilityFilter != 0
+		field.Searchable = field.Searchable && capability&CapabilitySearch != 0
+		field.Sortable = field.Sortable && capability&CapabilitySort != 0
+		field.Selectable = field.Selectable && capability&CapabilitySelect != 0
+		if capability&CapabilitySort == 0 {
+			granted.unsortable = append(granted.unsortable, field.param, field.Column)
+		}
+	}
+	if o.policyMetas != nil {
+		o.policyMetas[meta] = &granted
+	}
+	return &granted
+}
+
+// denyOrder replaces the order by the fields the policy denies ordering by with the default one and drops
+// the denied secondary orders. In the strict mode they're rejected.
+func denyOrder(meta *modelMeta, query *Query, o *options) error {
+	if meta == nil || len(meta.unsortable) == 0 {
+		return nil
+	}
+	orders := append([]Order{{Column: query.OrderBy}}, query.ThenBy...)
+	for _, order := range orders {
+		if o.strict && slices.Contains(meta.unsortable, order.Column) {
+			return &Error{Param: "order_by", Value: order.Column, Reason: "not sortable"}
+		}
+	}
+	query.ThenBy = slices.DeleteFunc(slices.Clone(query.ThenBy), func(order Order) bool {
+		return slices.Contains(meta.unsortable, order.Column)
+	})
+	if slices.Contains(meta.unsortable, query.OrderBy) {
+		query.OrderBy = "id"
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type StaffMember struct {
+	Id     uint
+	Name   string `filter:"filterable;searchable;sortable"`
+	Salary int    `filter:"filterable;sortable;selectable"`
+}
+
+// salaryPolicy only grants the HR role the capabilities of the salary.
+func salaryPolicy(c *gin.Context, field FieldMeta) Capability {
+	if field.Name == "Salary" && c.GetString("role") != "hr" {
+		return 0
+	}
+	return CapabilityAll
+}
+
+// TestFieldPolicy is a test for granting the capabilities of the fields per request.
+func (s *TestSuite) TestFieldPolicy() {
+	tests := []struct {
+		role     string
+		expected string
+	}{
+		{"hr", `WHERE "staff_members"."salary" > \$1 ORDER BY "salary","name" LIMIT \$2`},
+		{"staff", `ORDER BY "id","name" LIMIT \$1`},
+	}
+	for _, test := range tests {
+		var members []StaffMember
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodGet,
+			"/staff?filter=salary>50000&order_by=salary,name&order_direction=asc&fields=salary", nil)
+		ctx.Set("role", test.role)
+		s.mock.ExpectQuery(`^SELECT \* FROM "staff_members" ` + test.expected + `$`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "salary"}))
+		err := s.db.Model(&StaffMember{}).Scopes(FilterByQuery(ctx, ALL, WithFieldPolicy(salaryPolicy))).Find(&members).Error
+		s.NoError(err, test.role)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	for _, query := range []string{"filter=salary>50000", "order_by=salary"} {
+		var members []StaffMember
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/staff?"+query, nil)
+		err := s.db.Session(&gorm.Session{DryRun: true}).Model(&StaffMember{}).
+			Scopes(FilterByQuery(ctx, ALL, WithFieldPolicy(salaryPolicy), WithStrict())).Find(&members).Error
+		var filterErr *Error
+		s.True(errors.As(err, &filterErr), query)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var presets = struct {
+	sync.RWMutex
+	global map[string]string
+	models map[reflect.Type]map[string]string
+}{global: make(map[string]string), models: make(map[reflect.Type]map[string]string)}
+
+// RegisterPreset registers the named filter for all the models, applied with the preset query param
+// and ANDed with the other filters. The filter has the syntax of the filter param.
+// Example:
+//
+//	err := filter.RegisterPreset("active_admins", "role:admin,status:active")
+//	// ?preset=active_admins&filter=login~john
+func RegisterPreset(name, filter string) error {
+	if name == "" {
+		return errors.New("filter: preset name is empty")
+	}
+	presets.Lock()
+	defer presets.Unlock()
+	presets.global[name] = filter
+	return nil
+}
+
+// RegisterModelPreset registers the named filter for the model, preferred over the global preset with the same name.
+func RegisterModelPreset(model interface{}, name, filter string) error {
+	modelType := reflect.TypeOf(model)
+	for modelType != nil && modelType.Kind() == reflect.Ptr {
+		modelType = modelType.Elem()
+	}
+	if modelType == nil || modelType.Kind() != reflect.Struct {
+		return fmt.Errorf("filter: can't register preset for %T, model must be a struct", model)
+	}
+	if name == "" {
+		return errors.New("filter: preset name is empty")
+	}
+
+	presets.Lock()
+	defer presets.Unlock()
+	if presets.models[modelType] == nil {
+		presets.models[modelType] = make(map[string]string)
+	}
+	presets.models[modelType][name] = filter
+	return nil
+}
+
+// lookupPreset returns the filter of the preset of the model, falling back to the global one.
+func lookupPreset(modelType reflect.Type, name string) (string, bool) {
+	presets.RLock()
+	defer presets.RUnlock()
+	if filter, ok := presets.models[modelType][name]; ok {
+		return filter, true
+	}
+	filter, ok := presets.global[name]
+	return filter, ok
+}
+
+// expandPresets appends the filters of the presets to the filter phrases. Unknown presets are ignored
+// or rejected in the strict mode.
+func expandPresets(phrases, names []string, modelType reflect.Type, o *options) ([]string, error) {
+	expanded := make([]string, len(phrases), len(phrases)+len(names))
+	copy(expanded, phrases)
+	for _, name := range names {
+		filter, ok := lookupPreset(modelType, name)
+		if !ok {
+			if o.strict {
+				return nil, &Error{Param: "preset", Value: name, Reason: "unknown preset"}
+			}
+			continue
+		}
+		expanded = append(expanded, filter)
+	}
+	return expanded, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// TestPresets is a test for the filter presets ANDed with the filter params.
+func (s *TestSuite) TestPresets() {
+	s.Require().NoError(RegisterPreset("sample_users", "login:sampleUser,id>=10"))
+	s.Require().NoError(RegisterPreset("example_emails", "email~example.com"))
+
+	var users []User
+	values := url.Values{"preset": {"sample_users", "unknown"}, "filter": {"email~example"}}
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."email" LIKE \$1 AND \("users"."id" >= \$2 AND "users"."username" = \$3\)$`).
+		WithArgs("example", "10", "sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByValues(values, FILTER)).Find(&users).Error
+	s.NoError(err)
+
+	query, err := ParseQuery(url.Values{"preset": {"example_emails"}}, &User{}, FILTER)
+	s.Require().NoError(err)
+	s.Equal([]string{"example_emails"}, query.Presets)
+	s.Equal([]Condition{{Field: "Email", Param: "email", Column: "email", Operator: "~", Value: "example.com"}}, query.Filters)
+
+	query, err = ParseQuery(url.Values{"preset": {"example_emails"}}, &User{}, SEARCH)
+	s.Require().NoError(err)
+	s.Empty(query.Filters)
+}
+
+// TestModelPresets is a test for the model presets preferred over the global ones.
+func (s *TestSuite) TestModelPresets() {
+	s.Require().NoError(RegisterPreset("first", "id:1"))
+	s.Require().NoError(RegisterModelPreset(&Organization{}, "first", "id:2"))
+	s.Error(RegisterModelPreset(new(int), "first", "id:2"))
+	s.Error(RegisterPreset("", "id:2"))
+
+	query, err := ParseQuery(url.Values{"preset": {"first"}}, &Organization{}, FILTER)
+	s.Require().NoError(err)
+	s.Equal("2", query.Filters[0].Value)
+
+	query, err = ParseQuery(url.Values{"preset": {"first"}}, &User{}, FILTER)
+	s.Require().NoError(err)
+	s.Equal("1", query.Filters[0].Value)
+}
+
+// TestPresetsStrict is a test for rejecting the unknown presets in the strict mode.
+func (s *TestSuite) TestPresetsStrict() {
+	_, err := ParseQuery(url.Values{"preset": {"unknown"}}, &User{}, FILTER, WithStrict())
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal("preset", filterErr.Param)
+	s.Equal("unknown", filterErr.Value)
+}
@@ -0,0 +1,652 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm/clause"
+)
+
+// Condition is a filter condition parsed from the query.
+type Condition struct {
+	Field    string `json:"field"`
+	Param    string `json:"param"`
+	Column   string `json:"column"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+	// PII is set for the conditions on the fields with the personal data, see Field.PII.
+	PII bool `json:"pii,omitempty"`
+	// phrase is the index of the filter param the condition was parsed from.
+	phrase int
+	// having conditions are applied in HAVING.
+	having bool
+	// defaulted conditions are of the default values of the fields, so they're ANDed with filter_logic=or too.
+	defaulted bool
+	// expression is the expression of the custom operator.
+	expression clause.Expression
+}
+
+// Order is an order of the query.
+type Order struct {
+	Column string `json:"column"`
+	Desc   bool   `json:"desc,omitempty"`
+}
+
+// Query is the structured representation of the query parameters.
+type Query struct {
+	Filters       []Condition `json:"filters"`
+	Search        string      `json:"search,omitempty"`
+	SearchColumns []string    `json:"search_columns,omitempty"`
+	Page          int         `json:"page,omitempty"`
+	PageSize      int         `json:"page_size,omitempty"`
+	All           bool        `json:"all,omitempty"`
+	WithCount     bool        `json:"with_count,omitempty"`
+	OrderBy       string      `json:"order_by,omitempty"`
+	OrderDesc     bool        `json:"order_desc,omitempty"`
+	// SearchCase is the case sensitivity of the search requested with search_case=sensitive|insensitive,
+	// CaseDefault leaves it to the options. It doesn't apply to the fields tagged with their own case.
+	SearchCase Case `json:"search_case,omitempty"`
+	// Cursor is the time cursor replacing the page, see WithTimeCursor.
+	Cursor *TimeCursor `json:"cursor,omitempty"`
+	// IDs are the primary keys listed in the ids param, nil if it's absent. The empty ids match no rows.
+	IDs []string `json:"ids,omitempty"`
+	// Deduplicated is the number of the filter conditions dropped as the exact duplicates of the other ones.
+	Deduplicated int `json:"deduplicated,omitempty"`
+	// ThenBy contains the orders applied after the OrderBy one.
+	ThenBy []Order `json:"then_by,omitempty"`
+	// Fields contains the params of the fields to select.
+	Fields []string `json:"fields,omitempty"`
+	// ExcludeFields contains the params of the fields to leave out of the selection, ignored with Fields.
+	ExcludeFields []string `json:"exclude_fields,omitempty"`
+	// IncludeDeleted and OnlyDeleted select the soft-deleted rows too or only them.
+	IncludeDeleted bool `json:"include_deleted,omitempty"`
+	OnlyDeleted    bool `json:"only_deleted,omitempty"`
+	// Presets are the names of the filter presets, expanded into the conditions.
+	Presets []string `json:"presets,omitempty"`
+	// GroupBy is the param of the field the Aggregate buckets are grouped by.
+	GroupBy string `json:"group_by,omitempty"`
+	// Fieldsets contains the JSON:API sparse fieldsets by the resource type.
+	Fieldsets map[string][]string `json:"fieldsets,omitempty"`
+	// filter contains the raw filter params, resolved to the conditions against the model fields.
+	filter []string
+	// params contains the raw query params, resolved to the conditions of the bound params.
+	params url.Values
+	// explicitOrder reports whether the client ordered the rows explicitly.
+	explicitOrder bool
+	// orderFallback reports whether the order column was replaced with the fallback one.
+	orderFallback bool
+	// literal filter phrases are the single terms, so their values could contain commas.
+	literal bool
+	// filterOr ORs the filter params instead of ANDing them, see WithFilterCombinator.
+	filterOr bool
+	// defaults reports whether the default values of the fields apply, i.e. the filter is enabled.
+	defaults bool
+	// idsOrder keeps the order of the listed ids, see WithIDsOrder.
+	idsOrder bool
+	// matchesNothing reports whether the filters contradict each other, see ConflictsMatchNothing.
+	matchesNothing bool
+	// pageSize is the page size requested before it's limited, 0 for the default one.
+	pageSize int
+	// columns contains the boxed columns of the resolved conditions.
+	columns []interface{}
+	// where is the condition built apart from the filter params, e.g. of the AIP-160 filter.
+	where clause.Expression
+}
+
+// clone returns the copy of the query, sharing nothing the callers could modify.
+func (query Query) clone() Query {
+	query.Filters = slices.Clone(query.Filters)
+	query.SearchColumns = slices.Clone(query.SearchColumns)
+	query.ThenBy = slices.Clone(query.ThenBy)
+	query.Fields = slices.Clone(query.Fields)
+	query.ExcludeFields = slices.Clone(query.ExcludeFields)
+	if query.Fieldsets != nil {
+		fieldsets := make(map[string][]string, len(query.Fieldsets))
+		for member, fields := range query.Fieldsets {
+			fieldsets[member] = slices.Clone(fields)
+		}
+		query.Fieldsets = fieldsets
+	}
+	return query
+}
+
+// ParseQuery parses the query parameters into the structured representation without touching the DB,
+// e.g. for testing or building queries for other storages. The conditions are resolved against the
+// model fields, using the default naming strategy for the column names.
+// Example:
+//
+//	query, err := filter.ParseQuery(c.Request.URL.Query(), &User{}, filter.ALL)
+func ParseQuery(values url.Values, model interface{}, config int, opts ...Option) (Query, error) {
+	o := newOptions(opts)
+	query, err := parseQuery(values, ConfigFromBits(config), o)
+	if err != nil {
+		return Query{}, err
+	}
+	if _, meta, ok := defaultQueryMeta(model, o); ok {
+		if err := query.resolve(meta, o); err != nil {
+			return Query{}, err
+		}
+	}
+	limitPageSize(model, &query, o)
+	return query, nil
+}
+
+// parseQuery parses the query parameters enabled with the config, leaving the conditions unresolved.
+func parseQuery(values url.Values, config Config, o *options) (Query, error) {
+	params, err := parseParams(values)
+	if err != nil {
+		return Query{}, err
+	}
+	if o.syntax == JSONAPI {
+		if err := parseJSONAPIParams(values, &params); err != nil {
+			return Query{}, err
+		}
+	}
+	pageSize := params.PageSize
+	normalizePagination(&params)
+
+	query := Query{Filters: []Condition{}}
+	if config.Search {
+		if o.trimSpace {
+			params.Search = strings.TrimSpace(params.Search)
+		}
+		var truncated bool
+		if query.Search, truncated = truncate(params.Search, o.maxSearchLength); truncated && o.strict {
+			return Query{}, &Error{Param: "search", Value: params.Search, Reason: fmt.Sprintf("longer than %d characters", o.maxSearchLength)}
+		}
+		switch searchCase := values.Get("search_case"); searchCase {
+		case "":
+		case "sensitive":
+			query.SearchCase = CaseSensitive
+		case "insensitive":
+			query.SearchCase = CaseInsensitive
+		default:
+			if o.strict {
+				return Query{}, &Error{Param: "search_case", Value: searchCase, Reason: "must be sensitive or insensitive"}
+			}
+		}
+	}
+	if config.Filter {
+		query.filter = params.Filter
+		query.Presets = params.Presets
+		query.params = values
+		query.defaults = true
+		query.filterOr = o.filterOr
+		switch logic := values.Get("filter_logic"); logic {
+		case "":
+		case "and":
+			query.filterOr = false
+		case "or":
+			query.filterOr = true
+		default:
+			return Query{}, &Error{Param: "filter_logic", Value: logic, Reason: "must be and or or"}
+		}
+	}
+	if config.Paginate {
+		query.Page = params.Page
+		query.PageSize, query.pageSize = params.PageSize, max(pageSize, 0)
+		query.All = params.All
+		if query.WithCount, err = parseBoolParam(values, "with_count"); err != nil {
+			return Query{}, err
+		}
+		if o.timeCursor != "" {
+			if query.Cursor, err = parseTimeCursor(values, o); err != nil {
+				return Query{}, err
+			}
+		}
+	}
+	if config.OrderBy {
+		query.explicitOrder = values.Has("order_by") || values.Has("order_by[]") || len(params.Sort) > 0
+		if len(params.Sort) > 0 {
+			query.OrderBy = params.Sort[0].Column
+			query.OrderDesc = params.Sort[0].Desc
+			query.ThenBy = params.Sort[1:]
+		} else {
+			if o.strict && params.OrderDirection != "asc" && params.OrderDirection != "desc" {
+				return Query{}, &Error{Param: "order_direction", Value: params.OrderDirection, Reason: "must be asc or desc"}
+			}
+			// The direction applies to all the columns.
+			query.OrderBy = params.OrderBy[0]
+			query.OrderDesc = params.OrderDirection == "desc"
+			for _, column := range params.OrderBy[1:] {
+				query.ThenBy = append(query.ThenBy, Order{Column: column, Desc: query.OrderDesc})
+			}
+		}
+	}
+	if config.Fields {
+		query.Fields = params.Fields
+		query.ExcludeFields = params.ExcludeFields
+	}
+	if config.GroupBy {
+		query.GroupBy = params.GroupBy
+	}
+	if config.IDs {
+		if query.IDs, err = parseIDs(values, o); err != nil {
+			return Query{}, err
+		}
+		if query.IDs != nil && config.OrderBy && (query.OrderBy == idsOrderParam || o.idsOrder && !query.explicitOrder) {
+			// The ids order isn't a column, so it's neither folded nor checked against the sortable fields.
+			query.OrderBy, query.OrderDesc, query.ThenBy = idsOrderParam, false, nil
+			query.explicitOrder, query.idsOrder = false, true
+		}
+	}
+	// The params are ignored entirely without the opt-in, so the deleted rows can't leak.
+	if config.Deleted {
+		if query.IncludeDeleted, err = parseBoolParam(values, "include_deleted"); err != nil {
+			return Query{}, err
+		}
+		if query.OnlyDeleted, err = parseBoolParam(values, "only_deleted"); err != nil {
+			return Query{}, err
+		}
+	}
+	query.Fieldsets = params.Fieldsets
+	return query, nil
+}
+
+// parseParams parses the query parameters, setting the defaults for the absent ones.
+func parseParams(values url.Values) (queryParams, error) {
+	params := queryParams{
+		Filter:         arrayParam(values, "filter"),
+		Presets:        values["preset"],
+		GroupBy:        values.Get("group_by"),
+		Page:           1,
+		PageSize:       defaultPageSize,
+		OrderBy:        []string{"id"},
+		OrderDirection: "desc",
+	}
+
+	if search := arrayParam(values, "search"); len(search) > 0 {
+		params.Search = search[0]
+	}
+
+	var err error
+	if value, ok := lookupParam(values, "page"); ok {
+		if params.Page, err = parseInt(value); err != nil {
+			return params, &Error{Param: "page", Value: value, Reason: "must be an integer"}
+		}
+	}
+	if value, ok := lookupParam(values, "page_size"); ok {
+		if params.PageSize, err = parseInt(value); err != nil {
+			return params, &Error{Param: "page_size", Value: value, Reason: "must be an integer"}
+		}
+	}
+	if params.All, err = parseBoolParam(values, "all"); err != nil {
+		return params, err
+	}
+	if orderBy := splitFields(arrayParam(values, "order_by")); len(orderBy) > 0 {
+		params.OrderBy = orderBy
+	}
+	if value, ok := lookupParam(values, "order_direction"); ok {
+		params.OrderDirection = value
+	}
+	params.Fields = splitFields(values["fields"])
+	params.ExcludeFields = splitFields(values["exclude_fields"])
+	return params, nil
+}
+
+// arrayParam returns the values of the query parameter followed by the ones of the array-style key[],
+// e.g. filter[]=login:bob sent by the clients serializing the arrays with brackets.
+func arrayParam(values url.Values, key string) []string {
+	return slices.Concat(values[key], values[key+"[]"])
+}
+
+// lookupParam returns the first value of the query parameter, if it's present.
+func lookupParam(values url.Values, key string) (string, bool) {
+	if vs := values[key]; len(vs) > 0 {
+		return vs[0], true
+	}
+	return "", false
+}
+
+// parseBoolParam parses the boolean query parameter, an absent or empty one is false.
+func parseBoolParam(values url.Values, key string) (bool, error) {
+	value, ok := lookupParam(values, key)
+	if !ok || value == "" {
+		return false, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, &Error{Param: key, Value: value, Reason: "must be a boolean"}
+	}
+	return parsed, nil
+}
+
+// splitFields splits the comma separated field lists, skipping the empty ones.
+func splitFields(values []string) []string {
+	var fields []string
+	for _, value := range values {
+		for _, field := range strings.Split(value, ",") {
+			if field != "" {
+				fields = append(fields, field)
+			}
+		}
+	}
+	return fields
+}
+
+// truncate truncates the value to the maximum length in characters, reporting whether it was truncated.
+// A non-positive length disables the limit.
+func truncate(value string, length int) (string, bool) {
+	if length <= 0 || len(value) <= length || utf8.RuneCountInString(value) <= length {
+		return value, false
+	}
+	for i := range value {
+		if length == 0 {
+			return value[:i], true
+		}
+		length--
+	}
+	return value, false
+}
+
+// parseInt parses the integer query parameter, an empty value is zero.
+func parseInt(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
+
+// addCondition adds the condition on the field, truncating the value or rejecting it in the strict mode.
+func (query *Query) addCondition(field fieldMeta, operator, value string, phrase int, o *options) error {
+	if o.trimSpace {
+		value = strings.TrimSpace(value)
+	}
+	value, truncated := truncate(value, o.maxFilterLength)
+	if truncated && o.strict {
+		return fmt.Errorf("value longer than %d characters", o.maxFilterLength)
+	}
+	empty := value == "" && operator == ":" && field.kind == reflect.String && !field.INet && !field.LTree && !field.Range
+	if empty && o.emptyPolicy == EmptyRejected {
+		return errors.New("value must not be empty")
+	}
+	var expression clause.Expression
+	if field.count {
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return errors.New("count must be an integer")
+		}
+		expression = comparisonExpression(field.column, operator, count)
+	} else if field.INet {
+		var err error
+		if expression, err = inetExpression(field.column, operator, value); err != nil {
+			return err
+		}
+	} else if field.LTree {
+		var err error
+		if expression, err = ltreeExpression(field.column, operator, value); err != nil {
+			return err
+		}
+	} else if field.Range {
+		var err error
+		if expression, err = rangeExpression(field.column, operator, value); err != nil {
+			return err
+		}
+	} else if operator == fuzzyOperator {
+		var err error
+		if expression, err = fuzzyExpression(field.column, value, o); err != nil {
+			return err
+		}
+	} else if isGranularityOperator(operator) {
+		var err error
+		if expression, err = granularityExpression(field.column, operator, value, o.location); err != nil {
+			if o.strict {
+				return err
+			}
+			// The malformed periods are ignored like the other conditions without the matching field.
+			return nil
+		}
+	} else if build := lookupOperator(operator); build != nil {
+		var err error
+		if expression, err = customExpression(build, field, value); err != nil {
+			return err
+		}
+		if in, ok := expression.(clause.IN); ok && o.arrayIn {
+			expression = anyArray(in)
+		}
+	} else if operator == "!=" && field.nullable && (o.nullNotEqual || field.NullNotEqual) {
+		expression = clause.Or(clause.Neq{Column: field.column, Value: value}, clause.Eq{Column: field.column, Value: nil})
+	} else if empty && field.nullable && o.emptyPolicy == EmptyMeansNullOrEmpty {
+		expression = clause.Or(clause.Eq{Column: field.column, Value: ""}, clause.Eq{Column: field.column, Value: nil})
+	} else if operator == "~" && o.insensitiveLike && field.SearchCase != CaseSensitive {
+		expression = o.searchLikeExpression(&field, o.filterLikePattern(strings.ToLower(value), field.RawLike))
+	} else if operator == "~" && field.RawLike {
+		expression = o.likeExpression(field.column, o.filterLikePattern(value, true))
+	}
+	query.Filters = append(query.Filters, Condition{
+		Field:      field.Name,
+		Param:      field.param,
+		Column:     field.Column,
+		Operator:   operator,
+		Value:      value,
+		PII:        field.PII,
+		phrase:     phrase,
+		having:     field.Having,
+		expression: expression,
+	})
+	query.columns = append(query.columns, field.column)
+	return nil
+}
+
+// deduplicate drops the conditions on the same column with the same operator and value as the earlier ones,
+// e.g. of the filter params the client sent twice. The ORed filter params are only deduplicated within
+// the param, since dropping the condition from the other one would change the matched rows.
+func (query *Query) deduplicate(o *options) {
+	type conditionKey struct {
+		column, param, operator, value string
+		phrase                         int
+	}
+	query.Deduplicated = 0
+	seen := make(map[conditionKey]bool, len(query.Filters))
+	filters, columns := query.Filters[:0], query.columns[:0]
+	for i, condition := range query.Filters {
+		key := conditionKey{column: condition.Column, param: condition.Param, operator: condition.Operator, value: condition.Value}
+		if query.filterOr {
+			key.phrase = condition.phrase
+		}
+		if seen[key] {
+			query.Deduplicated++
+			if o.debugLogger != nil {
+				o.debugLogger("filter condition deduplicated", map[string]interface{}{
+					"param":    condition.Param,
+					"column":   condition.Column,
+					"operator": condition.Operator,
+					"value":    condition.Value,
+				})
+			}
+			continue
+		}
+		seen[key] = true
+		filters, columns = append(filters, condition), append(columns, query.columns[i])
+	}
+	query.Filters, query.columns = filters, columns
+}
+
+// resolveConflicts handles the equality conditions on the same column with the different values with
+// the policy, the identical ones are already deduplicated. Only the plain equality conditions conflict,
+// e.g. not the ones of the custom operators or the range bounds, and the ORed filter params never do.
+func (query *Query) resolveConflicts(o *options) error {
+	if o.conflictPolicy == ConflictsAnded || query.filterOr {
+		return nil
+	}
+	type columnKey struct{ param, column string }
+	var (
+		first  = make(map[columnKey]int, len(query.Filters)) // index of the first equality condition on the column
+		values = make(map[int][]interface{})                 // values of the merged conditions by the index
+	)
+	filters, columns := query.Filters[:0], query.columns[:0]
+	for i, condition := range query.Filters {
+		key := columnKey{param: condition.Param, column: condition.Column}
+		if condition.Operator != ":" || condition.expression != nil {
+			filters, columns = append(filters, condition), append(columns, query.columns[i])
+			continue
+		}
+		index, conflicts := first[key]
+		if !conflicts {
+			first[key] = len(filters)
+			filters, columns = append(filters, condition), append(columns, query.columns[i])
+			continue
+		}
+		switch o.conflictPolicy {
+		case ConflictsRejected:
+			return &Error{Param: "filter", Value: condition.Param + ":" + condition.Value, Reason: fmt.Sprintf(
+				"contradicts %s:%s, use filter_logic=or to match any of the values", condition.Param, filters[index].Value)}
+		case ConflictsMatchNothing:
+			query.matchesNothing = true
+			filters, columns = append(filters, condition), append(columns, query.columns[i])
+		case ConflictsIn:
+			if values[index] == nil {
+				values[index] = []interface{}{filters[index].Value}
+			}
+			values[index] = append(values[index], condition.Value)
+			filters[index].Value += "," + condition.Value
+		}
+	}
+	for index, merged := range values {
+		in := clause.IN{Column: columns[index], Values: merged}
+		filters[index].expression = in
+		if o.arrayIn {
+			filters[index].expression = anyArray(in)
+		}
+	}
+	query.Filters, query.columns = filters, columns
+	return nil
+}
+
+// defaultOptOut is the filter value opting out of the default value of the param, e.g. `status:*`.
+const defaultOptOut = "*"
+
+// hasDefault reports whether the field is filtered by the default value.
+func hasDefault(field Field) bool {
+	return field.Filterable && field.Default != ""
+}
+
+// resolve resolves the filter conditions, including the presets, and the search columns against the fields.
+// In the strict mode filter params without conditions are rejected.
+func (query *Query) resolve(meta *modelMeta, o *options) error {
+	phrases := query.filter
+	if len(query.Presets) > 0 {
+		var err error
+		if phrases, err = expandPresets(phrases, query.Presets, meta.modelType, o); err != nil {
+			return err
+		}
+	}
+
+	query.Filters = make([]Condition, 0, len(phrases))
+	query.columns = make([]interface{}, 0, len(phrases))
+	var (
+		buffer    [4]filterTerm
+		defaulted []string // params of the default values filtered by the client
+	)
+	for i, phrase := range phrases {
+		resolved, optedOut := len(query.Filters), false
+		terms := buffer[:0]
+		if !query.literal {
+			terms = appendFilterTerms(terms, phrase)
+		} else if term, ok := scanTerm(phrase); ok {
+			terms = append(terms, term)
+		}
+		if !o.caseSensitive {
+			for j := range terms {
+				terms[j].param = foldParam(meta, terms[j].param)
+			}
+		}
+		for _, field := range meta.fields {
+			if field.json {
+				// The keys of the JSON fields are filtered as the separate fields, e.g. preferences.theme.
+				for _, term := range terms {
+					key, ok := jsonKey(field, term)
+					if !ok {
+						continue
+					}
+					if err := query.addCondition(jsonKeyField(field, key), term.operator, term.value, i, o); err != nil {
+						return &Error{Param: "filter", Value: phrase, Reason: err.Error()}
+					}
+				}
+				continue
+			}
+			if o.strict && fuzzyDenied(field, terms) {
+				return &Error{Param: "filter", Value: phrase, Reason: "operator not allowed"}
+			}
+			operator, value, ok := matchFilter(field, terms)
+			if !ok {
+				continue
+			}
+			if field.Default != "" {
+				defaulted = append(defaulted, field.param)
+				if operator == ":" && value == defaultOptOut {
+					optedOut = true
+					continue
+				}
+			}
+			if err := query.addCondition(field, operator, value, i, o); err != nil {
+				return &Error{Param: "filter", Value: phrase, Reason: err.Error()}
+			}
+		}
+		if o.debugLogger != nil {
+			logConditions(o.debugLogger, phrase, terms, query.Filters[resolved:], meta)
+		}
+		if o.strict && len(query.Filters) == resolved && !optedOut {
+			return &Error{Param: "filter", Value: phrase, Reason: "no filterable field matches"}
+		}
+	}
+
+	// The bound params could also be passed as the bare query params, each one is a separate phrase.
+	phrase := len(phrases)
+	for _, field := range meta.fields {
+		if !field.Filterable || field.Operator == "" {
+			continue
+		}
+		for _, value := range query.params[field.param] {
+			if err := query.addCondition(field, field.Operator, value, phrase, o); err != nil {
+				return &Error{Param: field.param, Value: value, Reason: err.Error()}
+			}
+			phrase++
+		}
+	}
+
+	// The default values apply to the params the client doesn't filter.
+	for _, field := range meta.fields {
+		if !query.defaults || !hasDefault(field.Field) || slices.Contains(defaulted, field.param) {
+			continue
+		}
+		operator := ":"
+		if field.Operator != "" {
+			operator = field.Operator
+		}
+		resolved := len(query.Filters)
+		if err := query.addCondition(field, operator, field.Default, phrase, o); err != nil {
+			return &Error{Param: field.param, Value: field.Default, Reason: "invalid default: " + err.Error()}
+		}
+		for i := range query.Filters[resolved:] {
+			query.Filters[resolved+i].defaulted = true
+		}
+		phrase++
+	}
+	query.deduplicate(o)
+	if err := query.resolveConflicts(o); err != nil {
+		return err
+	}
+
+	query.SearchColumns = nil
+	if query.Search != "" {
+		query.SearchColumns = make([]string, 0, len(meta.fields))
+		for _, field := range meta.fields {
+			if field.Searchable {
+				query.SearchColumns = append(query.SearchColumns, field.Column)
+			}
+		}
+	}
+	return nil
+}
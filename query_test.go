@@ -0,0 +1,111 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// TestParseQuery is a test for parsing a complex query into the structured representation.
+func (s *TestSuite) TestParseQuery() {
+	values, err := url.ParseQuery("filter=login:sampleUser&filter=id>=5,email~example&filter=password:secret&search=John&page=3&page_size=500&order_by=email&order_direction=asc")
+	s.Require().NoError(err)
+
+	query, err := ParseQuery(values, &User{}, ALL)
+	s.NoError(err)
+	s.Equal([]Condition{
+		{Field: "Username", Param: "login", Column: "username", Operator: ":", Value: "sampleUser", phrase: 0},
+		{Field: "Id", Param: "id", Column: "id", Operator: ">=", Value: "5", phrase: 1},
+		{Field: "Email", Param: "email", Column: "email", Operator: "~", Value: "example", phrase: 1},
+	}, query.Filters)
+	s.Equal("John", query.Search)
+	s.Equal([]string{"username", "full_name"}, query.SearchColumns)
+	s.Equal(3, query.Page)
+	s.Equal(100, query.PageSize)
+	s.False(query.All)
+	s.Equal("email", query.OrderBy)
+	s.False(query.OrderDesc)
+}
+
+// TestParseQueryBrackets is a test for parsing the array-style keys alone and mixed with the plain ones.
+func (s *TestSuite) TestParseQueryBrackets() {
+	values, err := url.ParseQuery("filter[]=login:sampleUser&filter[]=id>5&search[]=John&order_by[]=email&order_by[]=id")
+	s.Require().NoError(err)
+
+	query, err := ParseQuery(values, &User{}, ALL)
+	s.NoError(err)
+	s.Equal([]Condition{
+		{Field: "Username", Param: "login", Column: "username", Operator: ":", Value: "sampleUser", phrase: 0},
+		{Field: "Id", Param: "id", Column: "id", Operator: ">", Value: "5", phrase: 1},
+	}, query.Filters)
+	s.Equal("John", query.Search)
+	s.Equal("email", query.OrderBy)
+	s.Equal([]Order{{Column: "id", Desc: true}}, query.ThenBy)
+
+	values, err = url.ParseQuery("filter=login:sampleUser&filter[]=email~example&search=John&search[]=Jane&order_by[]=email")
+	s.Require().NoError(err)
+
+	query, err = ParseQuery(values, &User{}, ALL)
+	s.NoError(err)
+	s.Equal([]Condition{
+		{Field: "Username", Param: "login", Column: "username", Operator: ":", Value: "sampleUser", phrase: 0},
+		{Field: "Email", Param: "email", Column: "email", Operator: "~", Value: "example", phrase: 1},
+	}, query.Filters)
+	s.Equal("John", query.Search)
+	s.Equal("email", query.OrderBy)
+}
+
+// TestParseQueryConfig is a test for parsing only the query parameters enabled with the config.
+func (s *TestSuite) TestParseQueryConfig() {
+	values, err := url.ParseQuery("filter=login:sampleUser&search=John&page=3")
+	s.Require().NoError(err)
+
+	query, err := ParseQuery(values, &User{}, FILTER)
+	s.NoError(err)
+	s.Len(query.Filters, 1)
+	s.Empty(query.Search)
+	s.Zero(query.Page)
+	s.Empty(query.OrderBy)
+}
+
+// TestParseQueryError is a test for parsing a malformed query.
+func (s *TestSuite) TestParseQueryError() {
+	values, err := url.ParseQuery("page=first")
+	s.Require().NoError(err)
+
+	_, err = ParseQuery(values, &User{}, ALL)
+	s.Error(err)
+}
+
+// TestMaxLength is a test for the search phrase and the filter values longer than the limits.
+func (s *TestSuite) TestMaxLength() {
+	values := url.Values{
+		"search": {strings.Repeat("ж", 300)},
+		"filter": {"login:" + strings.Repeat("a", 200)},
+	}
+
+	query, err := ParseQuery(values, &User{}, ALL)
+	s.Require().NoError(err)
+	s.Equal(strings.Repeat("ж", 256), query.Search)
+	s.Equal(strings.Repeat("a", 128), query.Filters[0].Value)
+
+	query, err = ParseQuery(values, &User{}, ALL, WithMaxLength(10, 0))
+	s.Require().NoError(err)
+	s.Equal(strings.Repeat("ж", 10), query.Search)
+	s.Equal(strings.Repeat("a", 200), query.Filters[0].Value)
+
+	_, err = ParseQuery(values, &User{}, ALL, WithStrict())
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal("search", filterErr.Param)
+	s.Equal("longer than 256 characters", filterErr.Reason)
+
+	_, err = ParseQuery(values, &User{}, ALL, WithStrict(), WithMaxLength(0, 128))
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal("filter", filterErr.Param)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// overlapOperator is the operator of the ranges overlapping the range, e.g. during&&2024-05-01..2024-05-07.
+const overlapOperator = "&&"
+
+// rangeOperators are the operators the Postgres range fields could be filtered with.
+var rangeOperators = []string{overlapOperator}
+
+// rangeSeparator separates the endpoints of the range values.
+const rangeSeparator = ".."
+
+// rangeTimeLayouts are the layouts of the range endpoints and the time cursors, the timestamps and the dates.
+var rangeTimeLayouts = []string{time.RFC3339Nano, time.DateOnly}
+
+// rangeDataType reports whether the column holds the Postgres tstzrange ranges.
+func rangeDataType(dataType schema.DataType) bool {
+	return strings.EqualFold(string(dataType), "tstzrange")
+}
+
+// rangeExpression builds the overlap condition on the range field. The value is the two endpoints separated
+// by `..`, either of them could be omitted for the unbounded range, e.g. `2024-05-01..`. The range includes
+// the lower endpoint and excludes the upper one.
+func rangeExpression(column interface{}, operator, value string) (clause.Expression, error) {
+	if operator != overlapOperator {
+		return nil, errors.New("range fields are only filtered with the && operator")
+	}
+	lower, upper, ok := strings.Cut(value, rangeSeparator)
+	if !ok || (lower == "" && upper == "") {
+		return nil, errors.New("value must be a time range, e.g. 2024-05-01..2024-05-07")
+	}
+	// The omitted endpoints are bound as NULL, the unbounded ones.
+	var from, to interface{}
+	if lower != "" {
+		t, err := parseRangeTime(lower)
+		if err != nil {
+			return nil, err
+		}
+		from = t
+	}
+	if upper != "" {
+		t, err := parseRangeTime(upper)
+		if err != nil {
+			return nil, err
+		}
+		if from != nil && from.(time.Time).After(t) {
+			return nil, errors.New("range start must not be after its end")
+		}
+		to = t
+	}
+	return clause.Expr{SQL: "? && tstzrange(?, ?)", Vars: []interface{}{column, from, to}}, nil
+}
+
+// parseRangeTime parses the range endpoint, the timestamp or the date.
+func parseRangeTime(value string) (time.Time, error) {
+	if t, ok := parseTimeValue(value, time.UTC); ok {
+		return t, nil
+	}
+	return time.Time{}, errors.New("range endpoint must be a date or an RFC 3339 time")
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"database/sql/driver"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+type Booking struct {
+	Id     uint
+	During string `gorm:"type:tstzrange" filter:"filterable"`
+	Slot   string `filter:"range;filterable"`
+	Name   string `filter:"filterable"`
+}
+
+// TestFilterRange is a test for filtering the time ranges by the overlapping ones.
+func (s *TestSuite) TestFilterRange() {
+	may1 := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		filter string
+		column string
+		args   []driver.Value
+	}{
+		{"during&&2024-05-01..2024-05-07", "during", []driver.Value{may1, time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC)}},
+		{"during&&2024-05-01..", "during", []driver.Value{may1, nil}},
+		{"slot&&..2024-05-01T12:30:00Z", "slot", []driver.Value{nil, time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)}},
+	}
+	for _, test := range tests {
+		var bookings []Booking
+		ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: "filter=" + url.QueryEscape(test.filter)}}}
+		s.mock.ExpectQuery(`^SELECT \* FROM "bookings" WHERE "bookings"."` + test.column + `" && tstzrange\(\$1, \$2\)$`).
+			WithArgs(test.args...).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "during", "slot", "name"}))
+		err := s.db.Model(&Booking{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&bookings).Error
+		s.NoError(err, test.filter)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	errorTests := []struct {
+		filter string
+		reason string
+	}{
+		{"during:2024-05-01..2024-05-07", "range fields are only filtered with the && operator"},
+		{"during&&2024-05-01", "value must be a time range, e.g. 2024-05-01..2024-05-07"},
+		{"during&&..", "value must be a time range, e.g. 2024-05-01..2024-05-07"},
+		{"during&&May..June", "range endpoint must be a date or an RFC 3339 time"},
+		{"during&&2024-05-07..2024-05-01", "range start must not be after its end"},
+	}
+	for _, test := range errorTests {
+		var bookings []Booking
+		r := httptest.NewRequest(http.MethodGet, "/bookings?filter="+url.QueryEscape(test.filter), nil)
+		err := s.db.Model(&Booking{}).Scopes(FilterByRequest(r, FILTER)).Find(&bookings).Error
+		var filterErr *Error
+		s.Require().True(errors.As(err, &filterErr), test.filter)
+		s.Equal(test.reason, filterErr.Reason)
+	}
+}
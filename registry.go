@@ -0,0 +1,215 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"gorm.io/gorm/schema"
+)
+
+var (
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	valuerType  = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+)
+
+// Field configures filtering for a single model field without `filter` tags.
+type Field struct {
+	// Name is the Go name of the struct field.
+	Name string
+	// Param is the query param name of the field, defaults to the name in the json tag, then to the column name.
+	Param string
+	// Column is the database column of the field, defaults to the column name
+	// from the model schema or the naming strategy.
+	Column     string
+	Filterable bool
+	Searchable bool
+	SearchCase Case
+	// SearchID fields are searched by equality for the integer phrases only, e.g. the ticket numbers
+	// pasted into the search box, tagged as `searchable:id`.
+	SearchID bool
+	// Sortable fields could be ordered by, tagged as `sortable`.
+	Sortable bool
+	// Selectable fields could be selected with the fields param, tagged as `selectable`.
+	Selectable bool
+	// Omit leaves the column out of the default selection, tagged as `omit`. It could be selected
+	// with the fields param if the field is also selectable.
+	Omit bool
+	// Having fields are the results of the aggregates, filtered in HAVING instead of WHERE,
+	// tagged as `having` or `having:{aggregate}`.
+	Having bool
+	// Aggregate is the SQL expression the HAVING conditions are applied to, e.g. COUNT(*),
+	// defaults to the unqualified column.
+	Aggregate string
+	// PII fields hold the personal data, so their filter values are redacted in the traces, tagged as `pii`.
+	PII bool
+	// INet fields hold the Postgres IP addresses, so they're compared as inet and filtered by the network
+	// with the << operator, tagged as `inet` or detected from the inet and cidr column types.
+	INet bool
+	// LTree fields hold the Postgres ltree paths, so they're filtered by the descendants with the <@ operator
+	// and by the ancestors with the @> operator, tagged as `ltree` or detected from the ltree column type.
+	LTree bool
+	// Range fields hold the Postgres tstzrange ranges, so they're filtered by the overlapping ranges with
+	// the && operator, e.g. `during&&2024-05-01..2024-05-07`, tagged as `range` or detected from the column type.
+	Range bool
+	// Fuzzy string fields could be filtered by the values within the Levenshtein distance with the ~? operator,
+	// e.g. `last_name~?smth:2`, tagged as `fuzzy`. Only for postgres with the fuzzystrmatch extension.
+	Fuzzy bool
+	// NullNotEqual fields include the NULL rows in the `!=` conditions if the column is nullable,
+	// tagged as `null_neq`, see WithNullNotEqual.
+	NullNotEqual bool
+	// RawLike fields pass the `~` filter values to LIKE verbatim, so the trusted clients could send their own
+	// `%` and `_` wildcards, tagged as `rawlike`. The other fields are still escaped, see WithRawLike.
+	RawLike bool
+	// Expr is the SQL expression the conditions, the search and the order are applied to instead of the column,
+	// e.g. COALESCE(nickname, full_name), tagged as `expr:{expression}`. It's static and trusted, the values
+	// are still bound. The fields ignored by gorm need the param.
+	Expr string
+	// Default is the value the filterable field is filtered by with the equality unless the client filters
+	// the param, e.g. only the active records, tagged as `default:{value}`. The `param:*` filter opts out.
+	Default string
+	// Operator binds the param to the filter operator, e.g. >= for created_after, so it's filtered with
+	// `filter=created_after:2024-01-01` or the bare `created_after=2024-01-01` param. Tagged as `op:{name}`
+	// with eq, ne, gt, gte, lt, lte or like, the fields with several bound params are tagged as
+	// `params:created_after:gte,created_before:lte`.
+	Operator string
+}
+
+// boundOperators are the filter operators by the names of the `op` tag.
+var boundOperators = map[string]string{
+	"eq":   ":",
+	"ne":   "!=",
+	"gt":   ">",
+	"gte":  ">=",
+	"lt":   "<",
+	"lte":  "<=",
+	"like": "~",
+}
+
+// Case is the case sensitivity of the field search.
+type Case int
+
+const (
+	CaseDefault     Case = iota // Search is case-insensitive for the dialect, unless WithPlainSearch is set
+	CaseInsensitive             // Search is case-insensitive even with MySQL collations, tagged as `searchable:ci`
+	CaseSensitive               // Search is a plain LIKE, tagged as `searchable:cs`
+)
+
+// Fields is a list of the field configurations of a model.
+type Fields []Field
+
+var registry = struct {
+	sync.RWMutex
+	models map[reflect.Type]Fields
+}{models: make(map[reflect.Type]Fields)}
+
+// RegisterModel configures filtering for a model that can't be annotated with `filter` tags,
+// e.g. structs generated by protoc or sqlc. Registered fields are used instead of struct tags.
+// Example:
+//
+//	err := filter.RegisterModel(&User{}, filter.Fields{
+//		{Name: "Username", Param: "login", Filterable: true, Searchable: true},
+//		{Name: "FullName", Searchable: true},
+//	})
+func RegisterModel(model interface{}, fields Fields) error {
+	modelType := reflect.TypeOf(model)
+	for modelType != nil && modelType.Kind() == reflect.Ptr {
+		modelType = modelType.Elem()
+	}
+	if modelType == nil || modelType.Kind() != reflect.Struct {
+		return fmt.Errorf("filter: can't register %T, model must be a struct", model)
+	}
+
+	// The field could be registered several times with the bound params.
+	seen := make(map[[2]string]bool, len(fields))
+	for _, field := range fields {
+		structField, ok := modelType.FieldByName(field.Name)
+		if !ok {
+			return fmt.Errorf("filter: model %v has no field %q", modelType, field.Name)
+		}
+		if !structField.IsExported() {
+			return fmt.Errorf("filter: field %q of model %v is not exported", field.Name, modelType)
+		}
+		if seen[[2]string{field.Name, field.Param}] {
+			return fmt.Errorf("filter: field %q of model %v is registered twice", field.Name, modelType)
+		}
+		seen[[2]string{field.Name, field.Param}] = true
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	registry.models[modelType] = append(Fields(nil), fields...)
+	invalidateMetaCache(modelType)
+	return nil
+}
+
+// modelFields returns the field configurations of the model from the registry,
+// falling back to the struct tags.
+func modelFields(modelType reflect.Type) Fields {
+	registry.RLock()
+	fields, ok := registry.models[modelType]
+	registry.RUnlock()
+	if ok {
+		return fields
+	}
+
+	structFields := modelStructFields(modelType)
+	fields = make(Fields, 0, len(structFields))
+	for _, structField := range structFields {
+		field, bound, _ := parseTag(structField.Name, structField.Tag.Get(tagKey))
+		fields = append(fields, field)
+		fields = append(fields, bound...)
+	}
+	return fields
+}
+
+// modelStructFields returns the struct fields of the model with the fields of the embedded structs in place
+// of them, the anonymous ones and the ones tagged as `gorm:"embedded"`, since gorm flattens their columns
+// into the model, e.g. with the embeddedPrefix.
+func modelStructFields(modelType reflect.Type) []reflect.StructField {
+	fields := make([]reflect.StructField, 0, modelType.NumField())
+	for i := 0; i < modelType.NumField(); i++ {
+		field := modelType.Field(i)
+		if embedded(field) {
+			fields = append(fields, modelStructFields(indirectType(field.Type))...)
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// embedded reports whether gorm flattens the columns of the struct field into the model. The structs
+// with their own filter tags and the ones stored as the single column, e.g. time.Time, aren't embedded.
+func embedded(field reflect.StructField) bool {
+	fieldType := indirectType(field.Type)
+	if fieldType.Kind() != reflect.Struct || fieldType == timeType || field.Tag.Get(tagKey) != "" {
+		return false
+	}
+	if reflect.PointerTo(fieldType).Implements(scannerType) || fieldType.Implements(valuerType) {
+		return false
+	}
+	_, tagged := schema.ParseTagSetting(field.Tag.Get("gorm"), ";")["EMBEDDED"]
+	return (field.Anonymous && field.IsExported()) || tagged
+}
+
+// lookupStructField returns the struct field of the model by the name, including the fields
+// of the embedded structs.
+func lookupStructField(modelType reflect.Type, name string) (reflect.StructField, bool) {
+	if field, ok := modelType.FieldByName(name); ok {
+		return field, true
+	}
+	for _, field := range modelStructFields(modelType) {
+		if field.Name == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"database/sql/driver"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// Account is a model without filter tags, configured only through the registry.
+type Account struct {
+	Id          uint
+	Login       string
+	DisplayName string
+	Secret      string
+}
+
+// TestRegistryFilter is a test for filtering a model configured with RegisterModel.
+func (s *TestSuite) TestRegistryFilter() {
+	s.Require().NoError(RegisterModel(&Account{}, Fields{
+		{Name: "Login", Param: "user", Filterable: true, Searchable: true},
+		{Name: "DisplayName", Searchable: true},
+	}))
+
+	var accounts []Account
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=user:sampleUser&filter=secret:123&search=John",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "accounts" WHERE \("accounts"."login" ILIKE \$1 OR "accounts"."display_name" ILIKE \$2\) AND "accounts"."login" = \$3$`).
+		WithArgs("%john%", "%john%", "sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "login", "display_name", "secret"}))
+	err := s.db.Model(&Account{}).Scopes(FilterByQuery(&ctx, FILTER|SEARCH)).Find(&accounts).Error
+	s.NoError(err)
+}
+
+// TestRegistryValidation is a test for field names validation on registration.
+func (s *TestSuite) TestRegistryValidation() {
+	s.Error(RegisterModel(&Account{}, Fields{{Name: "Unknown", Filterable: true}}))
+	s.Error(RegisterModel(&Account{}, Fields{{Name: "Login"}, {Name: "Login"}}))
+	s.Error(RegisterModel(new(int), Fields{}))
+}
+
+// TestJSONParam is a test for the param names falling back to the json tags.
+func (s *TestSuite) TestJSONParam() {
+	type Member struct {
+		Id          uint   `json:"id" filter:"filterable"`
+		DisplayName string `json:"displayName,omitempty" filter:"filterable;selectable"`
+		Nickname    string `json:"nick" filter:"param:alias;filterable"`
+		Email       string `json:"-" filter:"filterable"`
+	}
+
+	var members []Member
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=displayName:John&filter=alias:jj&filter=nick:ignored&filter=email:john@example.com&fields=displayName",
+		},
+	}
+
+	s.mock.ExpectQuery(`^SELECT "id","display_name" FROM "members" WHERE "members"."display_name" = \$1 AND "members"."nickname" = \$2 `+
+		`AND "members"."email" = \$3$`).
+		WithArgs("John", "jj", "john@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "display_name"}))
+	err := s.db.Model(&Member{}).Scopes(FilterByQueryConfig(&ctx, Config{Filter: true, Fields: true})).Find(&members).Error
+	s.NoError(err)
+}
+
+// Event is a model with the params bound to the operators.
+type Event struct {
+	Id        uint      `filter:"param:id;filterable"`
+	Title     string    `filter:"param:title;filterable;op:like"`
+	CreatedAt time.Time `filter:"filterable;params:created_after:gte,created_before:lte"`
+}
+
+// TestBoundParams is a test for the params bound to the operators, passed in the filter and as the bare params.
+func (s *TestSuite) TestBoundParams() {
+	tests := []struct {
+		query    string
+		expected string
+		args     []driver.Value
+	}{
+		{"filter=created_after:2024-01-01&filter=created_before:2024-02-01",
+			`^SELECT \* FROM "events" WHERE "events"."created_at" >= \$1 AND "events"."created_at" <= \$2$`,
+			[]driver.Value{"2024-01-01", "2024-02-01"}},
+		{"created_after=2024-01-01&created_before=2024-02-01&filter=title:party",
+			`^SELECT \* FROM "events" WHERE "events"."title" LIKE \$1 AND "events"."created_at" >= \$2 AND "events"."created_at" <= \$3$`,
+			[]driver.Value{"party", "2024-01-01", "2024-02-01"}},
+		{"filter=created_at>2024-01-01&filter=created_after>2024-01-01&filter=title~party",
+			`^SELECT \* FROM "events" WHERE "events"."created_at" > \$1$`,
+			[]driver.Value{"2024-01-01"}},
+	}
+
+	for _, test := range tests {
+		s.Run(test.query, func() {
+			var events []Event
+			ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: test.query}}}
+			s.mock.ExpectQuery(test.expected).
+				WithArgs(test.args...).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "title", "created_at"}))
+			err := s.db.Model(&Event{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&events).Error
+			s.NoError(err)
+		})
+	}
+
+	s.NoError(RegisterModel(&Account{}, Fields{
+		{Name: "Id", Param: "id_from", Filterable: true, Operator: ">="},
+		{Name: "Id", Param: "id_to", Filterable: true, Operator: "<="},
+	}))
+}
+
+// TestJSONParamOrder is a test for ordering by the json tag names of the sortable fields.
+func (s *TestSuite) TestJSONParamOrder() {
+	type Contributor struct {
+		Id          uint   `json:"id"`
+		DisplayName string `json:"displayName,omitempty" filter:"sortable"`
+		Nickname    string `json:"nick" filter:"param:alias;sortable"`
+	}
+
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"order_by=displayName&order_direction=asc", `ORDER BY "display_name"`},
+		{"order_by=alias,displayName", `ORDER BY "nickname" DESC,"display_name" DESC`},
+		{"order_by=display_name", `ORDER BY "display_name" DESC`},
+	}
+	for _, test := range tests {
+		var contributors []Contributor
+		ctx := gin.Context{Request: &http.Request{URL: &url.URL{RawQuery: test.query}}}
+		s.mock.ExpectQuery(`^SELECT \* FROM "contributors" ` + test.expected + `$`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "display_name", "nickname"}))
+		err := s.db.Model(&Contributor{}).Scopes(FilterByQuery(&ctx, ORDER_BY)).Find(&contributors).Error
+		s.NoError(err, test.query)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"reflect"
+
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// countSuffix is the suffix of the params of the relation counts, e.g. users.count.
+const countSuffix = ".count"
+
+// comparisonOperators are the operators the relation counts could be compared with.
+var comparisonOperators = []string{":", "!=", ">", ">=", "<", "<="}
+
+var countType = reflect.TypeOf(int64(0))
+
+// relationCountMeta computes the metadata of the count of the filterable has-many or many2many relation,
+// compared with the correlated subquery. The param defaults to the table of the related model.
+func relationCountMeta(field Field, relationship *schema.Relationship) (fieldMeta, bool) {
+	if !field.Filterable || (relationship.Type != schema.HasMany && relationship.Type != schema.Many2Many) {
+		return fieldMeta{}, false
+	}
+
+	table := relationship.FieldSchema.Table
+	if relationship.JoinTable != nil {
+		table = relationship.JoinTable.Table
+	}
+	conditions := make([]clause.Expression, 0, len(relationship.References))
+	for _, reference := range relationship.References {
+		foreignKey := clause.Column{Table: table, Name: reference.ForeignKey.DBName}
+		switch {
+		case reference.PrimaryKey == nil:
+			// The polymorphic type of the related rows.
+			conditions = append(conditions, clause.Eq{Column: foreignKey, Value: reference.PrimaryValue})
+		case reference.OwnPrimaryKey:
+			primaryKey := clause.Column{Table: clause.CurrentTable, Name: reference.PrimaryKey.DBName}
+			conditions = append(conditions, clause.Eq{Column: foreignKey, Value: primaryKey})
+		}
+	}
+	if len(conditions) == 0 {
+		return fieldMeta{}, false
+	}
+
+	if field.Param == "" {
+		field.Param = relationship.FieldSchema.Table
+	}
+	field.Param += countSuffix
+	field.Searchable, field.Sortable, field.Selectable, field.Having = false, false, false, false
+	meta := newFieldMeta(field, countType, clause.CurrentTable)
+	meta.count = true
+	meta.column = clause.Expr{
+		SQL:  "(SELECT COUNT(*) FROM ? WHERE ?)",
+		Vars: []interface{}{clause.Table{Name: table}, clause.And(conditions...)},
+	}
+	return meta, true
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestRelationCount is a test for filtering by the count of the has-many relation rows.
+func (s *TestSuite) TestRelationCount() {
+	var organizations []Organization
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/organizations?filter=users.count>5", nil)}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "organizations" WHERE \(SELECT COUNT\(\*\) FROM "users" ` +
+		`WHERE "users"."organization_id" = "organizations"."id"\) > \$1$`).
+		WithArgs(int64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	err := s.db.Model(&Organization{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&organizations).Error
+	s.NoError(err)
+
+	// LIKE isn't a count comparison, so the condition is ignored.
+	ctx = gin.Context{Request: httptest.NewRequest(http.MethodGet, "/organizations?filter=users.count~5", nil)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "organizations"$`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	err = s.db.Model(&Organization{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&organizations).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	r := httptest.NewRequest(http.MethodGet, "/organizations?filter=users.count:many", nil)
+	err = s.db.Model(&Organization{}).Scopes(FilterByRequest(r, FILTER)).Find(&organizations).Error
+	var filterErr *Error
+	s.Require().True(errors.As(err, &filterErr))
+	s.Equal("filter", filterErr.Param)
+	s.Equal("count must be an integer", filterErr.Reason)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// filterOperators are the filter operators, the compound ones (such as >=) come before
+// the single ones (such as >), so the longest operator is matched.
+var filterOperators = [...]string{"!=", ">=", "<=", fuzzyOperator, containedOperator, descendantOperator, ancestorOperator, overlapOperator,
+	yearOperator, monthOperator, weekOperator, dayOperator, ":", ">", "<", "~"}
+
+// operatorChars are the characters the built-in operators start with.
+const operatorChars = ":!<>~@&"
+
+// filterTerm is a single condition of the filter phrase, e.g. "age>=18".
+type filterTerm struct {
+	param    string
+	operator string
+	value    string
+	quoted   bool // the value was quoted, so it could contain the commas and the operator characters
+}
+
+// scanFilter splits the filter phrase into the comma separated terms. The param of the term
+// ends at the first operator character, the rest after the operator is the value. The value in double quotes
+// is taken literally up to the closing quote, with the backslash escaping the quotes and the backslashes,
+// e.g. note:"priority>high, \"urgent\"". Malformed terms, e.g. without the operator, are skipped.
+func scanFilter(phrase string) []filterTerm {
+	return appendFilterTerms(make([]filterTerm, 0, strings.Count(phrase, ",")+1), phrase)
+}
+
+// appendFilterTerms appends the terms of the filter phrase, so the caller could scan into a buffer.
+func appendFilterTerms(terms []filterTerm, phrase string) []filterTerm {
+	for len(phrase) > 0 {
+		term, rest := phrase, ""
+		if i := strings.IndexByte(phrase, ','); i >= 0 {
+			term, rest = phrase[:i], phrase[i+1:]
+		}
+		parsed, ok := scanTerm(term)
+		if ok && strings.HasPrefix(parsed.value, `"`) {
+			// The quoted value could contain the commas, so it's unquoted from the rest of the phrase.
+			if value, end, quoted := unquoteValue(phrase[len(term)-len(parsed.value):]); quoted {
+				parsed.value, parsed.quoted = value, true
+				rest = strings.TrimPrefix(end, ",")
+			}
+		}
+		if ok {
+			terms = append(terms, parsed)
+		}
+		phrase = rest
+	}
+	return terms
+}
+
+// unquoteValue unquotes the value starting with the double quote, returning the rest of the phrase after it.
+// The value isn't quoted if the closing quote is missing or it isn't followed by the end of the term,
+// so such values are taken as is.
+func unquoteValue(phrase string) (value, rest string, ok bool) {
+	var builder strings.Builder
+	for i := 1; i < len(phrase); i++ {
+		switch phrase[i] {
+		case '\\':
+			if i+1 < len(phrase) {
+				i++
+			}
+			builder.WriteByte(phrase[i])
+		case '"':
+			rest = phrase[i+1:]
+			if rest != "" && rest[0] != ',' {
+				return "", "", false
+			}
+			return builder.String(), rest, true
+		default:
+			builder.WriteByte(phrase[i])
+		}
+	}
+	return "", "", false
+}
+
+func scanTerm(term string) (filterTerm, bool) {
+	chars, symbols := operatorChars, filterOperators[:]
+	if set := operators.Load(); set != nil {
+		chars, symbols = set.chars, set.symbols
+	}
+	i := strings.IndexAny(term, chars)
+	if i <= 0 {
+		return filterTerm{}, false
+	}
+	for _, operator := range symbols {
+		if strings.HasPrefix(term[i:], operator) {
+			return filterTerm{param: term[:i], operator: operator, value: term[i+len(operator):]}, true
+		}
+	}
+	return filterTerm{}, false
+}
+
+// matchFilter looks up the filterable field condition in the scanned filter terms.
+func matchFilter(field fieldMeta, terms []filterTerm) (operator, value string, ok bool) {
+	if !field.Filterable {
+		return "", "", false
+	}
+	for _, term := range terms {
+		if term.param != field.param {
+			continue
+		}
+		if !operatorApplies(field, term.operator) {
+			return "", "", false
+		}
+		// The bound params are only filtered with the equality syntax.
+		if field.Operator != "" {
+			return field.Operator, term.value, term.operator == ":"
+		}
+		return term.operator, term.value, true
+	}
+	return "", "", false
+}
+
+// foldParam returns the declared param matching the param of the request only by case, including the prefixes
+// of the JSON keys, the param itself if it matches exactly or there is no such field.
+func foldParam(meta *modelMeta, param string) string {
+	if slices.ContainsFunc(meta.fields, func(field fieldMeta) bool { return field.param == param }) {
+		return param
+	}
+	for _, field := range meta.fields {
+		if strings.EqualFold(field.param, param) {
+			return field.param
+		}
+	}
+	// The JSON keys stay case-sensitive, e.g. Preferences.theme.
+	if prefix, key, ok := strings.Cut(param, "."); ok {
+		for _, field := range meta.fields {
+			if field.json && strings.EqualFold(field.param, prefix) {
+				return field.param + "." + key
+			}
+		}
+	}
+	return param
+}
+
+// operatorApplies reports whether the filter operator applies to the field, the relation counts are only
+// compared and the inet and ltree operators only apply to the fields of these types. The range fields accept
+// any operator, so the other ones are rejected with the error instead of being ignored.
+func operatorApplies(field fieldMeta, operator string) bool {
+	switch {
+	case operator == fuzzyOperator:
+		return field.Fuzzy && (field.kind == reflect.String || field.kind == reflect.Invalid)
+	case field.count:
+		return slices.Contains(comparisonOperators, operator)
+	case field.INet:
+		return slices.Contains(inetOperators, operator)
+	case field.LTree:
+		return slices.Contains(ltreeOperators, operator)
+	case field.Range:
+		return true
+	default:
+		return operator != containedOperator && operator != descendantOperator && operator != ancestorOperator &&
+			operator != overlapOperator && (field.valueType == TypeTime || !isGranularityOperator(operator))
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+// TestScanFilter is a test for splitting the filter phrase into the terms.
+func (s *TestSuite) TestScanFilter() {
+	s.Equal([]filterTerm{
+		{param: "login", operator: ":", value: "bob"},
+		{param: "age", operator: ">=", value: "18"},
+		{param: "state", operator: "!=", value: "FAIL"},
+		{param: "email", operator: ":", value: "a:b>c"},
+		{param: "name", operator: "~", value: ""},
+	}, scanFilter("login:bob,age>=18,state!=FAIL,email:a:b>c,name~"))
+
+	s.Empty(scanFilter(""))
+	s.Empty(scanFilter("login,:bob,a!b,a=b,,"))
+
+	s.Equal([]filterTerm{
+		{param: "note", operator: ":", value: "priority>high", quoted: true},
+		{param: "version", operator: "~", value: `1.2.3-rc<test>, "b:c"\`, quoted: true},
+		{param: "age", operator: ">=", value: "18"},
+	}, scanFilter(`note:"priority>high",version~"1.2.3-rc<test>, \"b:c\"\\",age>=18`))
+	// The values without the closing quote at the end of the term aren't unquoted.
+	s.Equal([]filterTerm{
+		{param: "note", operator: ":", value: `"a"b`},
+		{param: "login", operator: ":", value: `"bob`},
+	}, scanFilter(`note:"a"b,login:"bob`))
+
+	// The param is matched exactly, not as the suffix of the other param.
+	_, _, ok := matchFilter(newFieldMeta(Field{Name: "Id", Column: "id", Filterable: true}, nil, ""), scanFilter("organization_id:8"))
+	s.False(ok)
+}
+
+// TestQuotedFilterValue is a test for the quoted filter values with the operator characters and the commas.
+func (s *TestSuite) TestQuotedFilterValue() {
+	var users []User
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet,
+		"/users?filter="+url.QueryEscape(`login:"bob>alice, \"jr\"",email:"a:b@example.com"`), nil)}
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1 AND "users"."email" = \$2$`).
+		WithArgs(`bob>alice, "jr"`, "a:b@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(&ctx, FILTER)).Find(&users).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	query, err := ParseQuery(url.Values{"filter": {`login~"50%,off"`}}, &User{}, FILTER)
+	s.NoError(err)
+	s.Equal("50%,off", query.Filters[0].Value)
+	s.Equal("~", query.Filters[0].Operator)
+}
+
+func FuzzParseFilter(f *testing.F) {
+	for _, seed := range []string{"login:bob", "age>=18,state!=FAIL", "a:b:c", ",,", "!=", "x~%_", "\xff:\x00", `a:"b,c"`, `a:"\"`} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, phrase string) {
+		terms := scanFilter(phrase)
+		if len(terms) > strings.Count(phrase, ",")+1 {
+			t.Fatalf("%d terms scanned from %q", len(terms), phrase)
+		}
+		for _, term := range terms {
+			if term.param == "" || strings.ContainsAny(term.param, ",:!<>~") {
+				t.Fatalf("malformed term %+v scanned from %q", term, phrase)
+			}
+			if term.quoted {
+				if !strings.Contains(phrase, term.param+term.operator+`"`) {
+					t.Fatalf("quoted term %+v isn't a part of %q", term, phrase)
+				}
+				continue
+			}
+			if strings.Contains(term.value, ",") || !strings.Contains(phrase, term.param+term.operator+term.value) {
+				t.Fatalf("term %+v isn't a part of %q", term, phrase)
+			}
+		}
+	})
+}
+
+func BenchmarkParseFilter(b *testing.B) {
+	fields := make([]fieldMeta, 30)
+	for i := range fields {
+		fields[i] = newFieldMeta(Field{Name: fmt.Sprintf("Field%d", i), Column: fmt.Sprintf("field_%d", i), Filterable: true}, nil, "")
+	}
+	phrases := make([]string, 10)
+	for i := range phrases {
+		phrases[i] = fmt.Sprintf("field_%d>=%d", i*3, i)
+	}
+
+	b.Run("regexp", func(b *testing.B) {
+		matchers := make([]*regexp.Regexp, len(fields))
+		for i, field := range fields {
+			matchers[i] = regexp.MustCompile(fmt.Sprintf(`(?m)%v(:|!=|>=|<=|>|<|~)([^,]*).*`, field.param))
+		}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, phrase := range phrases {
+				for _, matcher := range matchers {
+					matcher.FindStringSubmatch(phrase)
+				}
+			}
+		}
+	})
+	b.Run("scanner", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for _, phrase := range phrases {
+				terms := scanFilter(phrase)
+				for _, field := range fields {
+					matchFilter(field, terms)
+				}
+			}
+		}
+	})
+}
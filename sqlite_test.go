@@ -0,0 +1,257 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+//go:build sqlite
+
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// SQLiteSuite runs the requests against the in-memory SQLite database, it requires cgo:
+//
+//	go test -tags sqlite ./...
+type SQLiteSuite struct {
+	suite.Suite
+	db *gorm.DB
+}
+
+func (s *SQLiteSuite) SetupTest() {
+	var err error
+	s.db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), s.db.AutoMigrate(&Organization{}, &User{}, &Article{}, &Customer{}, &Event{}))
+
+	require.NoError(s.T(), s.db.Create([]Organization{{Id: 1, Name: "Acme"}, {Id: 2, Name: "Globex"}}).Error)
+	require.NoError(s.T(), s.db.Create([]User{
+		{Id: 1, Username: "john", FullName: "John Smith", Email: "john@example.com", OrganizationId: 1},
+		{Id: 2, Username: "jane", FullName: "Jane Doe", Email: "jane@example.com", OrganizationId: 1},
+		{Id: 3, Username: "bob_1", FullName: "Bob Johnson", Email: "bob@example.org", OrganizationId: 1},
+		{Id: 4, Username: "alice", FullName: "Alice Brown", Email: "alice@example.org", OrganizationId: 2},
+		{Id: 5, Username: "bobby", FullName: "Bobby Tables", Email: "bobby@example.net", OrganizationId: 2},
+	}).Error)
+	require.NoError(s.T(), s.db.Create([]Article{
+		{Title: "John Smith", Body: "Hello", Code: "JS"},
+		{Title: "Jane Doe", Body: "Dear JOHN", Code: "JD"},
+		{Title: "Bob", Body: "Bye", Code: "john"},
+	}).Error)
+	require.NoError(s.T(), s.db.Create([]Customer{{Id: 1, Name: "bob"}, {Id: 2, Name: "alice"}, {Id: 3, Name: "alice"}, {Id: 4, Name: "carol"}}).Error)
+	require.NoError(s.T(), s.db.Delete(&Customer{Id: 1}).Error)
+	require.NoError(s.T(), s.db.Create([]Event{
+		{Id: 1, Title: "Launch", CreatedAt: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{Id: 2, Title: "Review", CreatedAt: time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)},
+		{Id: 3, Title: "Launch party", CreatedAt: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)},
+	}).Error)
+}
+
+func (s *SQLiteSuite) TearDownTest() {
+	db, err := s.db.DB()
+	require.NoError(s.T(), err)
+	db.Close()
+}
+
+func (s *SQLiteSuite) context(target string) *gin.Context {
+	return &gin.Context{Request: httptest.NewRequest(http.MethodGet, target, nil)}
+}
+
+func userIds(users []User) []uint {
+	ids := make([]uint, 0, len(users))
+	for _, user := range users {
+		ids = append(ids, user.Id)
+	}
+	return ids
+}
+
+// TestFilterOperators is a test for the filter operators.
+func (s *SQLiteSuite) TestFilterOperators() {
+	tests := []struct {
+		query    string
+		raw      bool
+		expected []uint
+	}{
+		{"filter=login:john", false, []uint{1}},
+		{"filter=login!=john", false, []uint{5, 4, 3, 2}},
+		{"filter=id>3", false, []uint{5, 4}},
+		{"filter=id>=3", false, []uint{5, 4, 3}},
+		{"filter=id<2", false, []uint{1}},
+		{"filter=id<=2", false, []uint{2, 1}},
+		{"filter=login~bob_1", false, []uint{3}},
+		{"filter=login~bob_%25", false, []uint{}},
+		{"filter=login~bob_%25", true, []uint{5, 3}},
+		{"filter=email~%25example.org", true, []uint{4, 3}},
+		{"filter=id>1,id<5&filter=email~%25example.com", true, []uint{2}},
+		{"filter=login:JOHN", false, []uint{}},
+	}
+
+	for _, test := range tests {
+		s.Run(test.query, func() {
+			var users []User
+			opts := []Option{}
+			if test.raw {
+				opts = append(opts, WithRawLike())
+			}
+			ctx := s.context("/users?" + test.query)
+			err := s.db.Model(&User{}).Scopes(FilterByQuery(ctx, FILTER|ORDER_BY, opts...)).Find(&users).Error
+			s.NoError(err)
+			s.Equal(test.expected, userIds(users))
+		})
+	}
+}
+
+// TestSearch is a test for the plain LIKE search, which is case-insensitive in SQLite.
+func (s *SQLiteSuite) TestSearch() {
+	var articles []Article
+	ctx := s.context("/articles?search=JOHN")
+
+	statement := s.db.Session(&gorm.Session{DryRun: true}).Model(&Article{}).Scopes(FilterByQuery(ctx, SEARCH)).Find(&articles).Statement
+	s.Equal("SELECT * FROM `articles` WHERE (`articles`.`title` LIKE ? OR `articles`.`body` LIKE ? OR `articles`.`code` LIKE ?)",
+		statement.SQL.String())
+	s.Equal([]interface{}{"%john%", "%john%", "%JOHN%"}, statement.Vars)
+
+	err := s.db.Model(&Article{}).Scopes(FilterByQuery(ctx, SEARCH)).Order("id").Find(&articles).Error
+	s.NoError(err)
+	s.Require().Len(articles, 3)
+	s.Equal([]string{"John Smith", "Jane Doe", "Bob"}, []string{articles[0].Title, articles[1].Title, articles[2].Title})
+
+	var users []User
+	err = s.db.Model(&User{}).Scopes(FilterByQuery(s.context("/users?search=BOB_"), SEARCH|ORDER_BY)).Find(&users).Error
+	s.NoError(err)
+	s.Equal([]uint{3}, userIds(users))
+}
+
+// TestPaginate is a test for the pages and the order.
+func (s *SQLiteSuite) TestPaginate() {
+	tests := []struct {
+		query    string
+		expected []uint
+	}{
+		{"page_size=2", []uint{5, 4}},
+		{"page=2&page_size=2", []uint{3, 2}},
+		{"page=3&page_size=2", []uint{1}},
+		{"page=4&page_size=2", []uint{}},
+		{"page=2&page_size=2&all=true", []uint{5, 4, 3, 2, 1}},
+		{"page_size=3&order_by=username&order_direction=asc", []uint{4, 3, 5}},
+		{"order_by=organization_id,username&order_direction=asc", []uint{3, 2, 1, 4, 5}},
+	}
+
+	for _, test := range tests {
+		s.Run(test.query, func() {
+			var users []User
+			err := s.db.Model(&User{}).Scopes(FilterByQuery(s.context("/users?"+test.query), ALL)).Find(&users).Error
+			s.NoError(err)
+			s.Equal(test.expected, userIds(users))
+		})
+	}
+}
+
+// TestRelevanceOrder is a test for ordering the searched rows by the relevance.
+func (s *SQLiteSuite) TestRelevanceOrder() {
+	var users []User
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(s.context("/users?search=bob"), ALL, WithRelevanceOrder())).Find(&users).Error
+	s.NoError(err)
+	s.Equal([]uint{5, 3}, userIds(users))
+
+	err = s.db.Model(&User{}).Scopes(FilterByQuery(s.context("/users?search=john"), ALL, WithRelevanceOrder())).Find(&users).Error
+	s.NoError(err)
+	s.Equal([]uint{1, 3}, userIds(users))
+}
+
+// TestRelationCount is a test for filtering by the count of the related rows.
+func (s *SQLiteSuite) TestRelationCount() {
+	var organizations []Organization
+	ctx := s.context("/organizations?filter=users.count>2")
+	err := s.db.Model(&Organization{}).Scopes(FilterByQuery(ctx, FILTER)).Find(&organizations).Error
+	s.NoError(err)
+	s.Require().Len(organizations, 1)
+	s.Equal("Acme", organizations[0].Name)
+}
+
+// TestDistinct is a test for the distinct rows and counts of the joined rows.
+func (s *SQLiteSuite) TestDistinct() {
+	var (
+		organizations []Organization
+		total         int64
+	)
+	ctx := s.context("/organizations?filter=id>0")
+	config := Config{Filter: true, Distinct: true}
+	joined := func() *gorm.DB {
+		return s.db.Model(&Organization{}).Joins("JOIN users ON users.organization_id = organizations.id")
+	}
+	err := joined().Scopes(FilterByQueryConfig(ctx, config)).Count(&total).Error
+	s.NoError(err)
+	s.Equal(int64(2), total)
+
+	err = joined().Scopes(FilterByQueryConfig(ctx, config)).Find(&organizations).Error
+	s.NoError(err)
+	s.Len(organizations, 2)
+}
+
+// TestDeleted is a test for including the soft-deleted rows.
+func (s *SQLiteSuite) TestDeleted() {
+	var customers []Customer
+	config := Config{Filter: true, Deleted: true}
+	err := s.db.Model(&Customer{}).Scopes(FilterByQueryConfig(s.context("/customers?filter=name:bob"), config)).Find(&customers).Error
+	s.NoError(err)
+	s.Empty(customers)
+
+	ctx := s.context("/customers?filter=name:bob&include_deleted=true")
+	err = s.db.Model(&Customer{}).Scopes(FilterByQueryConfig(ctx, config)).Find(&customers).Error
+	s.NoError(err)
+	s.Len(customers, 1)
+
+	err = s.db.Model(&Customer{}).Scopes(FilterByQueryConfig(s.context("/customers?only_deleted=true"), config)).Find(&customers).Error
+	s.NoError(err)
+	s.Require().Len(customers, 1)
+	s.Equal("bob", customers[0].Name)
+}
+
+// TestBoundParams is a test for the time params bound to the operators.
+func (s *SQLiteSuite) TestBoundParams() {
+	var events []Event
+	ctx := s.context("/events?created_after=2024-02-01&created_before=2024-03-01&filter=title:launch")
+	err := s.db.Model(&Event{}).Scopes(FilterByQuery(ctx, FILTER)).Find(&events).Error
+	s.NoError(err)
+	s.Empty(events)
+
+	err = s.db.Model(&Event{}).Scopes(FilterByQuery(s.context("/events?created_after=2024-02-01"), FILTER)).Find(&events).Error
+	s.NoError(err)
+	s.Len(events, 2)
+}
+
+// TestCount is a test for counting the filtered rows, along with the page.
+func (s *SQLiteSuite) TestCount() {
+	ctx := s.context("/users?filter=email!=bob@example.org&search=bob&page_size=1&with_count=true")
+	total, err := Count(ctx, s.db, &User{}, ALL)
+	s.NoError(err)
+	s.Equal(int64(1), total)
+
+	users, meta, err := FindPage[User](ctx, s.db, ALL)
+	s.NoError(err)
+	s.Equal([]uint{5}, userIds(users))
+	s.Require().NotNil(meta.Total)
+	s.Equal(int64(1), *meta.Total)
+}
+
+// TestAggregate is a test for counting the rows per group.
+func (s *SQLiteSuite) TestAggregate() {
+	ctx := s.context("/customers?group_by=name&filter=id>1")
+	buckets, err := Aggregate(ctx, s.db.Model(&Customer{}), Config{Filter: true, GroupBy: true})
+	s.NoError(err)
+	s.Equal([]Bucket{{Value: "alice", Count: 2}, {Value: "carol", Count: 1}}, buckets)
+}
+
+func TestRunSQLiteSuite(t *testing.T) {
+	suite.Run(t, new(SQLiteSuite))
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+// tagFlags are the tag tokens without values.
+var tagFlags = map[string]bool{
+	"filterable": true, "searchable": true, "searchable:ci": true, "searchable:cs": true, "searchable:id": true,
+	"sortable": true, "selectable": true, "omit": true, "pii": true, "inet": true, "ltree": true, "having": true,
+	"null_neq": true, "rawlike": true, "range": true, "fuzzy": true,
+}
+
+// paramValueRegexp matches the well-formed param names.
+var paramValueRegexp = regexp.MustCompile(`^\w+$`)
+
+// parseTag parses the filter tag of the struct field into the field configuration and the fields of the params
+// bound to the operators. The tokens are separated by `;`, the malformed ones are skipped with the reasons.
+func parseTag(name, tag string) (Field, []Field, []string) {
+	field := Field{Name: name}
+	var (
+		bound   []string
+		reasons []string
+	)
+	for _, token := range strings.Split(tag, ";") {
+		token = strings.TrimSpace(token)
+		key, value, hasValue := strings.Cut(token, ":")
+		switch {
+		case token == "":
+		case tagFlags[token]:
+			setTagFlag(&field, token)
+		case key == "param":
+			if !paramValueRegexp.MatchString(value) {
+				reasons = append(reasons, fmt.Sprintf("malformed param %q", value))
+				continue
+			}
+			field.Param = value
+		case key == "expr":
+			if value == "" {
+				reasons = append(reasons, "empty expr")
+				continue
+			}
+			field.Expr = value
+		case key == "default":
+			if value == "" {
+				reasons = append(reasons, "empty default")
+				continue
+			}
+			field.Default = value
+		case key == "having" && hasValue:
+			if value == "" {
+				reasons = append(reasons, "empty having aggregate")
+				continue
+			}
+			field.Having, field.Aggregate = true, value
+		case key == "op":
+			if boundOperators[value] == "" {
+				reasons = append(reasons, fmt.Sprintf("unknown operator %q", value))
+				continue
+			}
+			field.Operator = boundOperators[value]
+		case key == "params":
+			for _, param := range strings.Split(value, ",") {
+				paramName, operator, _ := strings.Cut(param, ":")
+				if !paramValueRegexp.MatchString(paramName) || boundOperators[operator] == "" {
+					reasons = append(reasons, fmt.Sprintf("malformed bound param %q", param))
+					continue
+				}
+				bound = append(bound, param)
+			}
+		default:
+			reasons = append(reasons, fmt.Sprintf("unknown token %q", token))
+		}
+	}
+
+	boundFields := make([]Field, 0, len(bound))
+	for _, param := range bound {
+		paramName, operator, _ := strings.Cut(param, ":")
+		boundFields = append(boundFields, Field{
+			Name:       name,
+			Param:      paramName,
+			Filterable: true,
+			PII:        field.PII,
+			RawLike:    field.RawLike,
+			Operator:   boundOperators[operator],
+		})
+	}
+	return field, boundFields, reasons
+}
+
+// setTagFlag sets the configuration of the flag token.
+func setTagFlag(field *Field, flag string) {
+	switch flag {
+	case "filterable":
+		field.Filterable = true
+	case "searchable":
+		field.Searchable = true
+	case "searchable:ci":
+		field.Searchable, field.SearchCase = true, CaseInsensitive
+	case "searchable:cs":
+		field.Searchable, field.SearchCase = true, CaseSensitive
+	case "searchable:id":
+		field.Searchable, field.SearchID = true, true
+	case "sortable":
+		field.Sortable = true
+	case "selectable":
+		field.Selectable = true
+	case "omit":
+		field.Omit = true
+	case "pii":
+		field.PII = true
+	case "inet":
+		field.INet = true
+	case "ltree":
+		field.LTree = true
+	case "range":
+		field.Range = true
+	case "having":
+		field.Having = true
+	case "fuzzy":
+		field.Fuzzy = true
+	case "null_neq":
+		field.NullNotEqual = true
+	case "rawlike":
+		field.RawLike = true
+	}
+}
+
+// tagErrorHandler is the handler of the malformed tags found at the first use of the models.
+var tagErrorHandler atomic.Pointer[func(err *TagError)]
+
+// SetTagErrorHandler sets the handler of the malformed filter tags found when the model is filtered for the first
+// time, process-wide. The malformed tokens are skipped and logged by default, the handler could panic instead
+// to fail fast in the tests, nil restores the logging. Use MustRegister or Validate to check the models on startup.
+// Example:
+//
+//	filter.SetTagErrorHandler(func(err *filter.TagError) {
+//		panic(err)
+//	})
+func SetTagErrorHandler(handler func(err *TagError)) {
+	tagErrorHandler.Store(&handler)
+}
+
+// reportTagErrors reports the malformed filter tags of the model to the handler.
+func reportTagErrors(modelType reflect.Type) {
+	handler := func(err *TagError) { log.Print(err) }
+	if custom := tagErrorHandler.Load(); custom != nil && *custom != nil {
+		handler = *custom
+	}
+	for _, field := range modelStructFields(modelType) {
+		_, _, reasons := parseTag(field.Name, field.Tag.Get(tagKey))
+		for _, reason := range reasons {
+			handler(&TagError{Model: modelType.Name(), Field: field.Name, Reason: reason})
+		}
+	}
+}
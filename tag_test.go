@@ -0,0 +1,65 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+)
+
+type Coupon struct {
+	Id       uint
+	Code     string `filter:"filterable; searchable"`
+	Owner    string `filter:"notsearchable;notfilterable"`
+	Campaign string `filter:"param campaign;filtrable"`
+	Discount int    `filter:"param:discount;filterable;op:between;params:min:gte,max:most"`
+}
+
+// TestParseTag is a test for parsing the filter tags into the tokens instead of matching the substrings.
+func (s *TestSuite) TestParseTag() {
+	field, bound, reasons := parseTag("Discount", "param:discount;filterable;op:gte;params:min_discount:gte,max:most;pii")
+	s.Equal(Field{Name: "Discount", Param: "discount", Filterable: true, PII: true, Operator: ">="}, field)
+	s.Equal([]Field{{Name: "Discount", Param: "min_discount", Filterable: true, PII: true, Operator: ">="}}, bound)
+	s.Equal([]string{`malformed bound param "max:most"`}, reasons)
+
+	field, _, reasons = parseTag("Owner", "notsearchable;notfilterable;searchable:ci")
+	s.Equal(Field{Name: "Owner", Searchable: true, SearchCase: CaseInsensitive}, field)
+	s.Equal([]string{`unknown token "notsearchable"`, `unknown token "notfilterable"`}, reasons)
+}
+
+// TestTagErrorHandler is a test for reporting the malformed tags at the first use of the model.
+func (s *TestSuite) TestTagErrorHandler() {
+	defer tagErrorHandler.Store(tagErrorHandler.Load())
+	var messages []string
+	SetTagErrorHandler(func(err *TagError) {
+		messages = append(messages, err.Error())
+	})
+
+	// The malformed tokens are skipped, so the owner and the campaign aren't filtered.
+	for i := 0; i < 2; i++ {
+		var coupons []Coupon
+		ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/coupons?search=x&filter=code:A,owner:bob,campaign:spring,discount:5", nil)}
+		s.mock.ExpectQuery(`^SELECT \* FROM "coupons" WHERE "coupons"."code" ILIKE \$1 AND \("coupons"."code" = \$2 AND "coupons"."discount" = \$3\)$`).
+			WithArgs("%x%", "A", "5").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "code", "owner", "campaign", "discount"}))
+		err := s.db.Model(&Coupon{}).Scopes(FilterByQuery(&ctx, SEARCH|FILTER)).Find(&coupons).Error
+		s.NoError(err)
+	}
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	// The errors are reported once, when the metadata is cached.
+	s.Equal([]string{
+		`filter: invalid field Coupon.Owner: unknown token "notsearchable"`,
+		`filter: invalid field Coupon.Owner: unknown token "notfilterable"`,
+		`filter: invalid field Coupon.Campaign: unknown token "param campaign"`,
+		`filter: invalid field Coupon.Campaign: unknown token "filtrable"`,
+		`filter: invalid field Coupon.Discount: unknown operator "between"`,
+		`filter: invalid field Coupon.Discount: malformed bound param "max:most"`,
+	}, messages)
+}
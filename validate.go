@@ -0,0 +1,120 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"gorm.io/gorm/schema"
+)
+
+// TagError describes a misconfigured field of the model, reported by Validate.
+type TagError struct {
+	Model  string
+	Field  string // Struct field name, empty for the errors of the whole model
+	Reason string
+}
+
+func (e *TagError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("filter: invalid model %s: %s", e.Model, e.Reason)
+	}
+	return fmt.Sprintf("filter: invalid field %s.%s: %s", e.Model, e.Field, e.Reason)
+}
+
+// Validate checks the filter configuration of the models, e.g. on startup or in a test, since the typos
+// in the tags silently disable the behavior. It reports the unknown tag tokens, the malformed params,
+// the params shared by several fields, the searchable fields which aren't strings and the sortable
+// or selectable fields ignored by gorm. The errors of all the models are joined, each one is the *TagError.
+// The columns are named with the default naming strategy.
+// Example:
+//
+//	func TestFilterTags(t *testing.T) {
+//		require.NoError(t, filter.Validate(&User{}, &Organization{}))
+//	}
+func Validate(models ...interface{}) error {
+	var errs []error
+	for _, model := range models {
+		errs = append(errs, validateModel(model)...)
+	}
+	return errors.Join(errs...)
+}
+
+// validateModel returns the errors of the model configuration.
+func validateModel(model interface{}) []error {
+	modelType := structType(model)
+	if modelType == nil {
+		return []error{&TagError{Model: fmt.Sprintf("%T", model), Reason: "model must be a struct"}}
+	}
+	modelSchema, err := schema.Parse(model, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		return []error{&TagError{Model: modelType.Name(), Reason: err.Error()}}
+	}
+
+	var errs []error
+	fail := func(field, reason string, args ...interface{}) {
+		errs = append(errs, &TagError{Model: modelType.Name(), Field: field, Reason: fmt.Sprintf(reason, args...)})
+	}
+
+	registry.RLock()
+	_, registered := registry.models[modelType]
+	registry.RUnlock()
+	if !registered {
+		for _, structField := range modelStructFields(modelType) {
+			_, _, reasons := parseTag(structField.Name, structField.Tag.Get(tagKey))
+			for _, reason := range reasons {
+				fail(structField.Name, "%s", reason)
+			}
+		}
+	}
+
+	for _, field := range modelFields(modelType) {
+		if field.Column != "" || field.Expr != "" || (!field.Sortable && !field.Selectable) {
+			continue
+		}
+		if _, isRelation := modelSchema.Relationships.Relations[field.Name]; isRelation {
+			continue
+		}
+		if schemaField := modelSchema.LookUpField(field.Name); schemaField == nil || schemaField.DBName == "" {
+			fail(field.Name, "sortable or selectable field is ignored by gorm")
+		}
+	}
+
+	_, meta, ok := defaultQueryMeta(model, &options{})
+	if !ok {
+		return errs
+	}
+	// The params are matched case-insensitively, so the ones differing only by case conflict too.
+	params := make(map[string]fieldMeta, len(meta.fields))
+	for _, field := range meta.fields {
+		if field.Searchable && field.SearchID && field.valueType != TypeInt {
+			fail(field.Name, "searchable:id field must be an integer")
+		} else if field.Searchable && !field.SearchID && field.kind != reflect.String {
+			fail(field.Name, "searchable field must be a string")
+		}
+		if field.Fuzzy && field.kind != reflect.String {
+			fail(field.Name, "fuzzy field must be a string")
+		}
+		if !field.Filterable && !field.Sortable && !field.Selectable {
+			continue
+		}
+		key := strings.ToLower(field.param)
+		if other, ok := params[key]; ok && other.Name != field.Name {
+			if other.param == field.param {
+				fail(field.Name, "param %q is also used by %s", field.param, other.Name)
+			} else {
+				fail(field.Name, "param %q differs only by case from %q of %s", field.param, other.param, other.Name)
+			}
+			continue
+		}
+		params[key] = field
+	}
+	return errs
+}
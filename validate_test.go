@@ -0,0 +1,46 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+)
+
+type Invoice struct {
+	Id        uint    `filter:"param:id;filterable"`
+	Number    string  `filter:"serchable"`
+	Code      string  `filter:"param: code;filterable"`
+	Reference string  `filter:"param:id;filterable"`
+	Total     float64 `filter:"searchable"`
+	Draft     bool    `gorm:"-" filter:"sortable"`
+	Due       string  `filter:"filterable;op:after"`
+}
+
+// TestValidate is a test for reporting the misconfigured fields of the models.
+func (s *TestSuite) TestValidate() {
+	s.NoError(Validate(&User{}, &Organization{}, []Article{}, Ticket{}))
+
+	err := Validate(&User{}, &Invoice{}, 42)
+	var tagErr *TagError
+	s.Require().True(errors.As(err, &tagErr))
+	s.Equal(&TagError{Model: "Invoice", Field: "Number", Reason: `unknown token "serchable"`}, tagErr)
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	s.Require().True(ok)
+	messages := make([]string, 0, len(joined.Unwrap()))
+	for _, err := range joined.Unwrap() {
+		messages = append(messages, err.Error())
+	}
+	s.Equal([]string{
+		`filter: invalid field Invoice.Number: unknown token "serchable"`,
+		`filter: invalid field Invoice.Code: malformed param " code"`,
+		`filter: invalid field Invoice.Due: unknown operator "after"`,
+		`filter: invalid field Invoice.Draft: sortable or selectable field is ignored by gorm`,
+		`filter: invalid field Invoice.Reference: param "id" is also used by Id`,
+		`filter: invalid field Invoice.Total: searchable field must be a string`,
+		`filter: invalid model int: model must be a struct`,
+	}, messages)
+}
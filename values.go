@@ -0,0 +1,145 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// FilterByValues works like FilterByQuery for the parsed query values, e.g. saved query strings
+// re-run from background jobs. Malformed query parameters are reported as the DB error.
+// Example:
+//
+//	values, _ := url.ParseQuery(savedQuery)
+//	err := db.Model(&User{}).Scopes(filter.FilterByValues(values, filter.ALL)).Find(&users).Error
+func FilterByValues(values url.Values, config int, opts ...Option) func(db *gorm.DB) *gorm.DB {
+	return filterByValues(values, nil, ConfigFromBits(config), newOptions(opts), nil)
+}
+
+// FilterByConditions filters DB request with the conditions on the filterable fields, resolved the same way
+// as the filter params. Only the Param, Operator and Value of the conditions are used, the values are taken
+// as is, so they could contain commas. Conditions rejected by the fields are reported as the DB error.
+// See the filtergen command for the typed conditions of the models.
+// Example:
+//
+//	conditions := []filter.Condition{{Param: "login", Operator: ":", Value: "john"}}
+//	err := db.Model(&User{}).Scopes(filter.FilterByConditions(conditions)).Find(&users).Error
+func FilterByConditions(conditions []Condition, opts ...Option) func(db *gorm.DB) *gorm.DB {
+	o := newOptions(opts)
+	query := Query{Filters: []Condition{}, filter: make([]string, 0, len(conditions)), literal: true, filterOr: o.filterOr}
+	for _, condition := range conditions {
+		query.filter = append(query.filter, condition.Param+condition.Operator+condition.Value)
+	}
+	return func(db *gorm.DB) *gorm.DB {
+		db, err := applyQuery(db, nil, query, Config{Filter: true}, o, nil)
+		if err != nil {
+			_ = addError(db, nil, o, err)
+		}
+		return db
+	}
+}
+
+// parsedKey is the gin context key of the queries parsed by the scopes, so the scope applied to
+// both the Count and the Find parses the query parameters once per request.
+const parsedKey = "filter:parsed"
+
+// parsedQuery is the query parsed by the scope, or the error it was rejected with.
+type parsedQuery struct {
+	query Query
+	err   error
+}
+
+// filterByValues builds the scope for the query values. The gin context is nil for the other adapters,
+// otherwise it's aborted on the malformed or rejected query parameters and the parsed query is cached
+// in it for the subsequent applications of the scope.
+func filterByValues(values url.Values, c *gin.Context, config Config, o *options, meta *Meta) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if parsed, ok := cachedQuery(c, o); ok {
+			return reapplyQuery(db, c, parsed, config, o, meta)
+		}
+		query, err := parseQuery(values, config, o)
+		if err == nil && c != nil {
+			// Malformed overrides are the server errors, so the context isn't aborted.
+			if err := overrideQuery(c, &query, config); err != nil {
+				_ = db.AddError(err)
+				return db
+			}
+		}
+		parsed := parsedQuery{query: query}
+		if err == nil {
+			db, err = applyQuery(db, c, query, config, o, meta)
+		}
+		if err != nil {
+			parsed.err = addError(db, c, o, err)
+		}
+		cacheQuery(c, o, parsed)
+		return db
+	}
+}
+
+// cachedQuery looks up the query parsed by the scope with the options in the gin context.
+func cachedQuery(c *gin.Context, o *options) (parsedQuery, bool) {
+	if c == nil {
+		return parsedQuery{}, false
+	}
+	queries, _ := c.Value(parsedKey).(map[*options]parsedQuery)
+	parsed, ok := queries[o]
+	return parsed, ok
+}
+
+// cacheQuery caches the query parsed by the scope with the options in the gin context.
+func cacheQuery(c *gin.Context, o *options, parsed parsedQuery) {
+	if c == nil {
+		return
+	}
+	queries, ok := c.Value(parsedKey).(map[*options]parsedQuery)
+	if !ok {
+		queries = make(map[*options]parsedQuery, 1)
+		c.Set(parsedKey, queries)
+	}
+	queries[o] = parsed
+}
+
+// reapplyQuery applies the cached query once more. The request was already audited, logged, observed and aborted
+// on the errors, so only the DB error is added.
+func reapplyQuery(db *gorm.DB, c *gin.Context, parsed parsedQuery, config Config, o *options, meta *Meta) *gorm.DB {
+	if parsed.err != nil {
+		_ = db.AddError(parsed.err)
+		return db
+	}
+	quiet := *o
+	quiet.auditHook, quiet.debugLogger, quiet.metrics = nil, nil, nil
+	db, err := applyQuery(db, c, parsed.query, config, &quiet, meta)
+	if err != nil {
+		if o.errorHandler != nil {
+			err = o.errorHandler(err)
+		}
+		_ = db.AddError(err)
+	}
+	return db
+}
+
+// addError adds the error of malformed or rejected query parameters to the DB, transformed with
+// the error handler. The gin context is aborted if it's not nil. The added error is returned.
+func addError(db *gorm.DB, c *gin.Context, o *options, err error) error {
+	status := http.StatusBadRequest
+	if filterErr := (*Error)(nil); errors.As(err, &filterErr) && filterErr.Status != 0 {
+		status = filterErr.Status
+	}
+	if o.errorHandler != nil {
+		err = o.errorHandler(err)
+	}
+	if c != nil {
+		_ = c.AbortWithError(status, err).SetType(gin.ErrorTypeBind)
+	}
+	_ = db.AddError(err)
+	return err
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2026 ActiveChooN
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package filter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// TestFilterByValues is a test for filtering with the query values built in code.
+func (s *TestSuite) TestFilterByValues() {
+	var users []User
+	values := url.Values{}
+	values.Add("filter", "login:sampleUser")
+	values.Add("search", "John")
+	values.Add("page", "3")
+	values.Add("page_size", "500")
+	values.Add("order_direction", "asc")
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE \("users"."username" ILIKE \$1 OR "users"."full_name" ILIKE \$2\) AND "users"."username" = \$3 ORDER BY "id" LIMIT \$4 OFFSET \$5$`).
+		WithArgs("%john%", "%john%", "sampleUser", 100, 200).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByValues(values, ALL)).Find(&users).Error
+	s.NoError(err)
+
+	ctx := gin.Context{}
+	ctx.Request = &http.Request{
+		URL: &url.URL{
+			RawQuery: "filter=login:sampleUser&search=John&page=3&page_size=500&order_direction=asc",
+		},
+	}
+	dryRun := s.db.Session(&gorm.Session{DryRun: true})
+	expected := dryRun.Model(&User{}).Scopes(FilterByQuery(&ctx, ALL)).Find(&users).Statement
+	actual := dryRun.Model(&User{}).Scopes(FilterByValues(values, ALL)).Find(&users).Statement
+	s.Equal(expected.SQL.String(), actual.SQL.String())
+	s.Equal(expected.Vars, actual.Vars)
+}
+
+// TestFilterByValuesDefaults is a test for the defaults of the empty query values.
+func (s *TestSuite) TestFilterByValuesDefaults() {
+	var users []User
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" ORDER BY "id" DESC LIMIT \$1$`).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByValues(url.Values{}, ALL)).Find(&users).Error
+	s.NoError(err)
+}
+
+// TestFilterByConditions is a test for filtering with the conditions built in code.
+func (s *TestSuite) TestFilterByConditions() {
+	var users []User
+	conditions := []Condition{
+		{Param: "login", Operator: ":", Value: "doe, john"},
+		{Param: "id", Operator: ">=", Value: "2"},
+		{Param: "password", Operator: ":", Value: "secret"},
+	}
+
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1 AND "users"."id" >= \$2$`).
+		WithArgs("doe, john", "2").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email", "password"}))
+	err := s.db.Model(&User{}).Scopes(FilterByConditions(conditions)).Find(&users).Error
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	err = s.db.Model(&User{}).Scopes(FilterByConditions(conditions[2:], WithStrict())).Find(&users).Error
+	s.EqualError(err, `filter: invalid filter "password:secret": no filterable field matches`)
+}
+
+// TestFilterByQueryBindError is a test for aborting the gin context on malformed query parameters.
+func (s *TestSuite) TestFilterByQueryBindError() {
+	var users []User
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/users?page_size=ten", nil)
+
+	err := s.db.Model(&User{}).Scopes(FilterByQuery(ctx, ALL)).Find(&users).Error
+	s.Error(err)
+	s.True(ctx.IsAborted())
+	s.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+// TestScopeParsedOnce is a test for parsing the query params once when the scope is applied twice.
+func (s *TestSuite) TestScopeParsedOnce() {
+	var (
+		users          []User
+		total          int64
+		audits, logged int
+	)
+	ctx := gin.Context{Request: httptest.NewRequest(http.MethodGet, "/users?filter=login:sampleUser", nil)}
+	scope := FilterByQuery(&ctx, FILTER,
+		WithAuditHook(func(c *gin.Context, query Query) { audits++ }),
+		WithDebugLogger(func(msg string, fields map[string]interface{}) { logged++ }),
+	)
+
+	s.mock.ExpectQuery(`^SELECT count\(\*\) FROM "users" WHERE "users"."username" = \$1$`).
+		WithArgs("sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+	s.mock.ExpectQuery(`^SELECT \* FROM "users" WHERE "users"."username" = \$1$`).
+		WithArgs("sampleUser").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "full_name", "email"}))
+	s.NoError(s.db.Model(&User{}).Scopes(scope).Count(&total).Error)
+	s.NoError(s.db.Model(&User{}).Scopes(scope).Find(&users).Error)
+	s.Equal(1, audits)
+	s.Equal(1, logged)
+	s.NoError(s.mock.ExpectationsWereMet())
+
+	// The rejected query aborts the context once, both statements fail.
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/users?page=two", nil)
+	scope = FilterByQuery(c, PAGINATE)
+	s.Error(s.db.Model(&User{}).Scopes(scope).Count(&total).Error)
+	s.Error(s.db.Model(&User{}).Scopes(scope).Find(&users).Error)
+	s.Len(c.Errors, 1)
+}